@@ -0,0 +1,240 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/config"
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/linting/rule"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+// runAdd adds an entry to a subsection of the Unreleased version, the version and the subsection are created if missing
+func runAdd(args []string) int {
+	flags := flag.NewFlagSet(args[0]+" add", flag.ExitOnError)
+	flagConfig := flags.String("config", "", "set linter configuration")
+	flagType := flags.String("type", "", "set the subsection of the entry, e.g. Fixed")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s add -type <subsection> [flags] <message> [changelog]\n", args[0])
+		flags.PrintDefaults()
+	}
+
+	freeArgs, err := parseInterspersed(flags, args[2:])
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	message := ""
+	if len(freeArgs) > 0 {
+		message = strings.TrimSpace(freeArgs[0])
+	}
+	if message == "" || *flagType == "" {
+		flags.Usage()
+		return codeRequestError
+	}
+
+	mainConfig, err := config.LoadConfig(*flagConfig)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	p, err := mainConfig.ChangelogParser()
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if p.Name() != (parser.Default{}).Name() {
+		fmt.Printf("unable to add entries to %s changelogs, only %s ones are supported\n", p.Name(), parser.Default{}.Name())
+		return codeRequestError
+	}
+
+	lintingConfig := mainConfig.LintingConfig()
+	subsection, err := subsectionName(*flagType, lintingConfig)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	_, sorted := lintingConfig.RuleArgs[rule.SubsectionOrder{}]
+
+	inputFilename := changelogFilename(freeArgs[1:])
+	source, err := os.ReadFile(inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	parse, err := newParseFunc(p, mainConfig, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	changes, err := parse(source)
+	if err != nil {
+		fmt.Println(err)
+		return codeSyntaxError
+	}
+
+	updated, _ := linting.ApplyEdits(source, addEdits(changes, source, subsection, message, sorted))
+	if _, err := parse(updated); err != nil {
+		fmt.Printf("unable to add the entry, the changelog would be malformed: %v\n", err)
+		return codeRequestError
+	}
+
+	if err := writeFile(inputFilename, updated); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	return codeOK
+}
+
+// subsectionName yields the name of the subsection of the given type.
+// If the subsection-naming rule is enabled, the type must be one of its allowed names, regardless of the case.
+func subsectionName(typ string, lintingConfig *linting.Config) (string, error) {
+	args, ok := lintingConfig.RuleArgs[rule.SubsectionNaming{}]
+	if !ok {
+		return typ, nil
+	}
+
+	allowed, err := rule.SubsectionNaming{}.AllowedNames(args)
+	if err != nil {
+		return "", err
+	}
+	for _, name := range allowed {
+		if strings.EqualFold(name, typ) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown subsection %q, allowed subsections are: %s", typ, strings.Join(allowed, ", "))
+}
+
+// addEdits yields the edits of the source adding the message as an entry of the given subsection of the Unreleased version.
+// The version and the subsection are created if missing, subsections are created in alphabetical order if sorted.
+// Created headings are followed by blank lines like the existing ones, and a created Unreleased version is linked
+// like the other versions if its compare link can be derived from the link of the latest version.
+// Inserted lines end like the first line of the source.
+func addEdits(changes *model.Changelog, source []byte, subsection, message string, sorted bool) []linting.Edit {
+	newline := linting.LineEnding(source)
+	blankLine := newline + newline
+	marker := "" // list marker of the first entry of the changelog
+	var unreleased *model.Version
+	versionGap, subsectionGap := blankLine, blankLine // line breaks after headings of versions and subsections
+	versionGapFound, subsectionGapFound := false, false
+	for _, v := range changes.Versions {
+		if v.Version == "Unreleased" && unreleased == nil {
+			unreleased = v
+		}
+		if len(v.Subsections) > 0 && !versionGapFound {
+			if gap, ok := gapBetween(source, v.Range, v.Subsections[0].Range, newline); ok {
+				versionGap, versionGapFound = gap, true
+			}
+		}
+		for _, s := range v.Subsections {
+			if marker == "" && len(s.History) > 0 {
+				marker = strings.TrimSpace(s.History[0].Summary)[:1]
+			}
+			if len(s.History) > 0 && !subsectionGapFound {
+				if gap, ok := gapBetween(source, s.Range, s.History[0].Range, newline); ok {
+					subsectionGap, subsectionGapFound = gap, true
+				}
+			}
+		}
+	}
+	if marker == "" {
+		marker = "-"
+	}
+	entry := marker + " " + strings.Join(strings.Split(message, "\n"), newline+strings.Repeat(" ", len(marker)+1))
+
+	insert := func(offset int, text string) []linting.Edit {
+		return []linting.Edit{{Start: offset, End: offset, NewText: text}}
+	}
+
+	if unreleased == nil {
+		heading := "## Unreleased"
+		block := "### " + subsection + subsectionGap + entry
+		if len(changes.Versions) == 0 { // after the header
+			end := len(strings.TrimRight(string(source), "\r\n"))
+			return []linting.Edit{{Start: end, End: len(source), NewText: blankLine + heading + versionGap + block + newline}}
+		}
+
+		latest := changes.Versions[0]
+		link, linkOffset, linked := unreleasedLink(changes.Links, latest)
+		if !linked {
+			return insert(latest.Range.Start.Offset, heading+versionGap+block+blankLine)
+		}
+		heading = "## [Unreleased]"
+		return append(insert(latest.Range.Start.Offset, heading+versionGap+block+blankLine), insert(linkOffset, link+newline)...)
+	}
+
+	for _, s := range unreleased.Subsections {
+		switch {
+		case s.Name == subsection && len(s.History) > 0:
+			return insert(s.Extent().End.Offset, newline+entry)
+		case s.Name == subsection:
+			return insert(s.Range.End.Offset, subsectionGap+entry)
+		}
+	}
+
+	block := "### " + subsection + subsectionGap + entry
+	if sorted {
+		for _, s := range unreleased.Subsections {
+			if s.Name > subsection {
+				return insert(s.Range.Start.Offset, block+blankLine)
+			}
+		}
+	}
+
+	if len(unreleased.Subsections) == 0 {
+		return insert(unreleased.Range.End.Offset, versionGap+block)
+	}
+
+	return insert(unreleased.Extent().End.Offset, blankLine+block)
+}
+
+// gapBetween yields as many line breaks as there are between the end of a heading and the start of the following node,
+// false if they are not separated by blank lines only
+func gapBetween(source []byte, heading, next model.Range, newline string) (string, bool) {
+	if heading.End.Offset > next.Start.Offset || next.Start.Offset > len(source) {
+		return "", false
+	}
+
+	gap := string(source[heading.End.Offset:next.Start.Offset])
+	breaks := strings.Count(gap, "\n")
+	if breaks == 0 || strings.TrimSpace(gap) != "" {
+		return "", false
+	}
+
+	return strings.Repeat(newline, breaks), true
+}
+
+// tagURLPattern matches the URLs of tags like https://github.com/owner/repo/releases/tag/v1.0.0
+var tagURLPattern = regexp.MustCompile(`^(\S+?/)(?:-/)?(?:releases/tag|tree|tags)/(\S+)$`)
+
+// unreleasedLink yields the link reference definition of the Unreleased version, comparing the tag of the latest version
+// with HEAD, and the offset where to insert it: before the definition of the latest version.
+// It returns false if the latest version has no link from which the tag and the compare URL can be derived.
+func unreleasedLink(links []*model.Link, latest *model.Version) (definition string, offset int, ok bool) {
+	for _, link := range links {
+		if !strings.EqualFold(link.Label, latest.Version) {
+			continue
+		}
+
+		if base, _, to, isCompare := link.Compare(); isCompare {
+			return fmt.Sprintf("[Unreleased]: %s%s...HEAD", base, to), link.Range.Start.Offset, true
+		}
+		if matches := tagURLPattern.FindStringSubmatch(link.URL); matches != nil {
+			return fmt.Sprintf("[Unreleased]: %scompare/%s...HEAD", matches[1], matches[2]), link.Range.Start.Offset, true
+		}
+		return "", 0, false
+	}
+
+	return "", 0, false
+}
@@ -0,0 +1,132 @@
+// Package baseline records the failures of a changelog to ignore them in later runs.
+// Failures are identified by their content (rule, node and message), not by their position,
+// thus a baseline remains valid when lines move.
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/chavacava/changelog-lint/linting"
+)
+
+// formatVersion is the version of the baseline file format
+const formatVersion = 1
+
+// staleRule is the rule name of the warnings about recorded failures that no longer occur
+const staleRule = "stale-baseline"
+
+// Entry is a recorded failure
+type Entry struct {
+	Rule    string `json:"rule"`
+	Node    string `json:"node,omitempty"`
+	Message string `json:"message"`
+	Count   int    `json:"count"` // number of identical failures
+}
+
+// Baseline is a set of recorded failures
+type Baseline struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"failures"`
+}
+
+type key struct {
+	rule, node, message string
+}
+
+func keyOf(failure linting.Failure) key {
+	return key{rule: failure.RuleName, node: failure.Node, message: failure.Message}
+}
+
+// New builds the baseline of the given failures
+func New(failures []linting.Failure) Baseline {
+	counts := map[key]int{}
+	for _, f := range failures {
+		counts[keyOf(f)]++
+	}
+
+	entries := make([]Entry, 0, len(counts))
+	for k, count := range counts {
+		entries = append(entries, Entry{Rule: k.rule, Node: k.node, Message: k.message, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		ei, ej := entries[i], entries[j]
+		if ei.Rule != ej.Rule {
+			return ei.Rule < ej.Rule
+		}
+		if ei.Node != ej.Node {
+			return ei.Node < ej.Node
+		}
+		return ei.Message < ej.Message
+	})
+
+	return Baseline{Version: formatVersion, Entries: entries}
+}
+
+// Load reads a baseline file
+func Load(filename string) (*Baseline, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the baseline: %v", err)
+	}
+
+	result := &Baseline{}
+	if err := json.Unmarshal(content, result); err != nil {
+		return nil, fmt.Errorf("bad baseline file %s: %v", filename, err)
+	}
+	if result.Version != formatVersion {
+		return nil, fmt.Errorf("bad baseline file %s: unsupported version %d (expected %d)", filename, result.Version, formatVersion)
+	}
+
+	return result, nil
+}
+
+// Write writes the baseline as JSON
+func (b Baseline) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b)
+}
+
+// Apply removes the recorded failures from the given ones.
+// Recorded failures that no longer occur are reported as warnings, so the baseline can be updated.
+func (b Baseline) Apply(failures []linting.Failure) []linting.Failure {
+	remaining := map[key]int{}
+	for _, e := range b.Entries {
+		remaining[key{rule: e.Rule, node: e.Node, message: e.Message}] += e.Count
+	}
+
+	result := []linting.Failure{}
+	for _, f := range failures {
+		k := keyOf(f)
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		result = append(result, f)
+	}
+
+	for _, e := range b.Entries {
+		k := key{rule: e.Rule, node: e.Node, message: e.Message}
+		if remaining[k] <= 0 {
+			continue
+		}
+
+		msg := fmt.Sprintf("recorded failure of rule %s no longer occurs: %s", e.Rule, e.Message)
+		if remaining[k] > 1 {
+			msg = fmt.Sprintf("%s (%d times)", msg, remaining[k])
+		}
+		result = append(result, linting.Failure{
+			RuleName: staleRule,
+			Message:  msg,
+			Node:     e.Node,
+			Severity: linting.SeverityWarning,
+		})
+		remaining[k] = 0 // entries with the same key are reported once
+	}
+
+	return result
+}
@@ -0,0 +1,78 @@
+package baseline_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/baseline"
+	"github.com/chavacava/changelog-lint/linting"
+)
+
+func TestBaseline(t *testing.T) {
+	recorded := []linting.Failure{
+		{RuleName: "subsection-repetition", Message: `duplicated subsection "Fixed"`, Position: 12, Node: "1.0.0/Fixed"},
+		{RuleName: "subsection-repetition", Message: `duplicated subsection "Fixed"`, Position: 18, Node: "1.0.0/Fixed"},
+		{RuleName: "version-order", Message: "version 0.9.0 is not well sorted", Position: 20, Node: "0.9.0"},
+	}
+
+	filename := filepath.Join(t.TempDir(), "baseline.json")
+	file, err := os.Create(filename)
+	if err != nil {
+		t.Fatalf("unable to create the baseline file: %v", err)
+	}
+	if err := baseline.New(recorded).Write(file); err != nil {
+		t.Fatalf("unable to write the baseline: %v", err)
+	}
+	file.Close()
+
+	b, err := baseline.Load(filename)
+	if err != nil {
+		t.Fatalf("unable to load the baseline: %v", err)
+	}
+	if len(b.Entries) != 2 || b.Entries[0].Count != 2 {
+		t.Fatalf("expected 2 entries, the first one counting 2 failures, got %+v", b.Entries)
+	}
+
+	// lines moved, one duplicated subsection and the misplaced version were fixed, a new failure appeared
+	current := []linting.Failure{
+		{RuleName: "subsection-repetition", Message: `duplicated subsection "Fixed"`, Position: 15, Node: "1.0.0/Fixed"},
+		{RuleName: "version-empty", Message: `empty version "1.1.0"`, Position: 4, Node: "1.1.0"},
+	}
+	got := []string{}
+	for _, f := range b.Apply(current) {
+		got = append(got, f.RuleName+" "+string(f.Severity)+": "+f.Message)
+	}
+
+	want := []string{
+		`version-empty : empty version "1.1.0"`,
+		`stale-baseline warning: recorded failure of rule subsection-repetition no longer occurs: duplicated subsection "Fixed"`,
+		`stale-baseline warning: recorded failure of rule version-order no longer occurs: version 0.9.0 is not well sorted`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected failures:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	testCases := map[string]string{
+		"malformed.json": `{"version": 1, "failures": [`,
+		"version.json":   `{"version": 42, "failures": []}`,
+	}
+
+	for name, content := range testCases {
+		filename := filepath.Join(dir, name)
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatalf("unable to write test data: %v", err)
+		}
+		if _, err := baseline.Load(filename); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := baseline.Load(filepath.Join(dir, "unknown.json")); err == nil {
+		t.Errorf("expected an error for a missing baseline file")
+	}
+}
@@ -5,23 +5,56 @@ import (
 	"fmt"
 	"io/ioutil"
 	"regexp"
+	"strings"
 
 	"github.com/BurntSushi/toml"
+	"github.com/chavacava/changelog-lint/formatter"
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/linting/rule"
 	"github.com/chavacava/changelog-lint/parser"
+	"github.com/chavacava/changelog-lint/versioning"
 )
 
 var allRules = []linting.Rule{
+	rule.DebianTrailerDate{},
+	rule.DebianUrgency{},
+	rule.LinkCompare{},
+	rule.LinkMissing{},
+	rule.LinkOrphan{},
 	rule.SubsectionEmpty{},
 	rule.SubsectionNaming{},
 	rule.SubsectionOrder{},
 	rule.SubsectionRepetition{},
+	rule.VersionDateFormat{},
+	rule.VersionDateFuture{},
+	rule.VersionDateMissing{},
+	rule.VersionDateOrder{},
 	rule.VersionEmpty{},
 	rule.VersionOrder{},
 	rule.VersionRepetition{},
 }
 
+// optInRules are disabled unless they are configured, e.g. by an empty [rule.version-date-format] section
+var optInRules = map[linting.Rule]bool{
+	rule.LinkCompare{}:        true,
+	rule.LinkMissing{}:        true,
+	rule.LinkOrphan{}:         true,
+	rule.VersionDateFormat{}:  true,
+	rule.VersionDateFuture{}:  true,
+	rule.VersionDateMissing{}: true,
+	rule.VersionDateOrder{}:   true,
+}
+
+var allFormatters = []formatter.Formatter{
+	formatter.Text{},
+	formatter.JSON{},
+	formatter.SARIF{},
+	formatter.Checkstyle{},
+	formatter.JUnit{},
+	formatter.GitHub{},
+	formatter.GitLab{},
+}
+
 // Arguments is type used for the arguments of a rule.
 type Arguments = []any
 
@@ -29,6 +62,7 @@ type Arguments = []any
 type RuleConfig struct {
 	Arguments Arguments
 	Disabled  bool
+	Severity  string // error (default), warning or info
 }
 
 type ParserPatterns struct {
@@ -38,17 +72,25 @@ type ParserPatterns struct {
 	Entry      string
 }
 
-// RuleConfig is type used for the rule configuration.
+// ParserConfig is type used for the parser configuration.
 type ParserConfig struct {
-	Patterns ParserPatterns
+	Format     string // name of the parser (see parser.Names)
+	Patterns   ParserPatterns
+	DateLayout string `toml:"date-layout"` // layout of release dates (see time.Parse), e.g. 2006-01-02
+}
+
+// VersioningConfig is type used for the versioning configuration.
+type VersioningConfig struct {
+	Scheme string // semver (default), calver:<format>, pep440 or lexical (see versioning.New)
 }
 
 // RulesConfig defines the config for all rules.
 type RulesConfig = map[string]RuleConfig
 
 type Config struct {
-	Rules  RulesConfig  `toml:"rule"`
-	Parser ParserConfig `toml:"parser"`
+	Rules      RulesConfig      `toml:"rule"`
+	Parser     ParserConfig     `toml:"parser"`
+	Versioning VersioningConfig `toml:"versioning"`
 }
 
 func (c Config) enabledRules() []linting.Rule {
@@ -73,7 +115,7 @@ func defaultRulesConfig() RulesConfig {
 	config := make(map[string]RuleConfig, len(allRules))
 
 	for _, r := range allRules {
-		config[r.Name()] = RuleConfig{}
+		config[r.Name()] = RuleConfig{Disabled: optInRules[r]}
 	}
 
 	return config
@@ -85,19 +127,31 @@ func defaultLintingConfig() *linting.Config {
 	}
 
 	for _, r := range allRules {
+		if optInRules[r] {
+			continue
+		}
 		config.RuleArgs[r] = nil
 	}
 
 	return config
 }
 
+const defaultParserFormat = "markdown"
 const defaultPatternTitle = `.+`
-const defaultPatternVersion = `^## \[?(\d+\.\d+.\d+|Unreleased)\]?( .*)*$`
+const defaultPatternVersion = `^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$`
+
+// defaultPatternAnyVersion is the default version pattern of versioning schemes other than semver,
+// the version is checked by the scheme
+const defaultPatternAnyVersion = `^## \[?(Unreleased|[^\s\[\]]+)\]?(?: +- +(?P<date>\S+))?( .*)*$`
+const defaultDateLayout = "2006-01-02"
 const defaultPatternSubsection = `^### ([A-Z]+[a-z]+)[ ]*$`
 const defaultPatternEntry = `^[*-] .+$`
+const defaultVersioningScheme = versioning.SchemeSemVer
 
 func defaultParseConf() ParserConfig {
 	return ParserConfig{
+		Format:     defaultParserFormat,
+		DateLayout: defaultDateLayout,
 		Patterns: ParserPatterns{
 			Title:      defaultPatternTitle,
 			Version:    defaultPatternVersion,
@@ -109,8 +163,9 @@ func defaultParseConf() ParserConfig {
 
 func defaultConf() *Config {
 	return &Config{
-		Rules:  defaultRulesConfig(),
-		Parser: defaultParseConf(),
+		Rules:      defaultRulesConfig(),
+		Parser:     defaultParseConf(),
+		Versioning: VersioningConfig{Scheme: defaultVersioningScheme},
 	}
 }
 
@@ -131,9 +186,22 @@ func LoadConfig(configFile string) (*Config, error) {
 	}
 
 	for k, v := range loadedConf.Rules {
+		if v.Severity != "" {
+			if _, err := linting.ParseSeverity(v.Severity); err != nil {
+				return nil, fmt.Errorf("bad configuration of rule %s in %s: %v", k, configFile, err)
+			}
+		}
 		defaultConf.Rules[k] = v
 	}
 
+	if loadedConf.Parser.Format != "" {
+		defaultConf.Parser.Format = loadedConf.Parser.Format
+	}
+
+	if loadedConf.Parser.DateLayout != "" {
+		defaultConf.Parser.DateLayout = loadedConf.Parser.DateLayout
+	}
+
 	if loadedConf.Parser.Patterns.Title != "" {
 		defaultConf.Parser.Patterns.Title = loadedConf.Parser.Patterns.Title
 	}
@@ -150,6 +218,16 @@ func LoadConfig(configFile string) (*Config, error) {
 		defaultConf.Parser.Patterns.Entry = loadedConf.Parser.Patterns.Entry
 	}
 
+	if loadedConf.Versioning.Scheme != "" {
+		if _, err := versioning.New(loadedConf.Versioning.Scheme); err != nil {
+			return nil, fmt.Errorf("bad versioning configuration in %s: %v", configFile, err)
+		}
+		defaultConf.Versioning.Scheme = loadedConf.Versioning.Scheme
+		if loadedConf.Parser.Patterns.Version == "" && loadedConf.Versioning.Scheme != versioning.SchemeSemVer {
+			defaultConf.Parser.Patterns.Version = defaultPatternAnyVersion
+		}
+	}
+
 	return defaultConf, nil
 }
 
@@ -158,9 +236,10 @@ func (c Config) LintingConfig() *linting.Config {
 		return defaultLintingConfig()
 	}
 
-	result := &linting.Config{RuleArgs: map[linting.Rule]any{}}
+	result := &linting.Config{RuleArgs: map[linting.Rule]any{}, RuleSeverities: map[linting.Rule]linting.Severity{}}
 	for _, r := range c.enabledRules() {
 		result.RuleArgs[r] = c.Rules[r.Name()].Arguments
+		result.RuleSeverities[r] = linting.Severity(c.Rules[r.Name()].Severity)
 	}
 
 	return result
@@ -188,6 +267,43 @@ func (c Config) ParserConfig() (*parser.Config, error) {
 		VersionPattern:    reVersion,
 		SubsectionPattern: reSubsection,
 		EntryPattern:      reEntry,
+		DateLayout:        c.Parser.DateLayout,
 	}
 	return result, nil
 }
+
+// VersioningScheme yields the configured versioning scheme
+func (c Config) VersioningScheme() (versioning.Scheme, error) {
+	return versioning.New(c.Versioning.Scheme)
+}
+
+// ChangelogParser yields the parser of the configured changelog format
+func (c Config) ChangelogParser() (parser.Parser, error) {
+	return parser.Get(c.Parser.Format)
+}
+
+// AllRules yields all available rules, including the release one
+func AllRules() []linting.Rule {
+	return append(append([]linting.Rule{}, allRules...), rule.Release{})
+}
+
+// GetFormatter yields the formatter with the given name
+func GetFormatter(name string) (formatter.Formatter, error) {
+	for _, f := range allFormatters {
+		if f.Name() == name {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown output format %q, available formats are: %s", name, strings.Join(FormatterNames(), ", "))
+}
+
+// FormatterNames yields the names of all available formatters
+func FormatterNames() []string {
+	result := make([]string, len(allFormatters))
+	for i, f := range allFormatters {
+		result[i] = f.Name()
+	}
+
+	return result
+}
@@ -19,9 +19,12 @@ func TestLoadConfigRulesPart(t *testing.T) {
 			t.Fatalf("rule %s not present in the conf %+v", r.Name(), got.Rules)
 		}
 
-		if len(rc.Arguments) != 0 || rc.Disabled {
+		if len(rc.Arguments) != 0 || rc.Disabled != optInRules[r] {
 			t.Fatalf("expected no conf for rule %s, got %+v", r.Name(), rc)
 		}
+		if _, enabled := got.LintingConfig().RuleArgs[r]; enabled == optInRules[r] {
+			t.Fatalf("expected rule %s to be enabled by default: %v", r.Name(), !optInRules[r])
+		}
 	}
 
 	// Error finding the conf file
@@ -36,6 +39,12 @@ func TestLoadConfigRulesPart(t *testing.T) {
 		t.Fatal("expected conf file parsing error but got no error")
 	}
 
+	// Error in rule severity
+	_, err = LoadConfig("./testdata/bad-severity.toml")
+	if err == nil {
+		t.Fatal("expected unknown severity error but got no error")
+	}
+
 	// Load real conf file
 	got, err = LoadConfig("./testdata/conf.toml")
 	if err != nil {
@@ -51,6 +60,15 @@ func TestLoadConfigRulesPart(t *testing.T) {
 			if !rc.Disabled {
 				t.Fatal("rule \"version-empty\" should be disabled")
 			}
+		case "version-date-format":
+			// opt-in rules are enabled by their section
+			if rc.Disabled {
+				t.Fatal("rule \"version-date-format\" should be enabled")
+			}
+		case "version-date-future":
+			if !rc.Disabled {
+				t.Fatal("rule \"version-date-future\" should be disabled")
+			}
 		default:
 			if !ok {
 				t.Fatalf("rule %s is not present in the conf %+v", r.Name(), got.LintingConfig().RuleArgs)
@@ -61,6 +79,14 @@ func TestLoadConfigRulesPart(t *testing.T) {
 			continue
 		}
 
+		wantSeverity := ""
+		if r.Name() == "subsection-order" {
+			wantSeverity = "warning"
+		}
+		if rc.Severity != wantSeverity {
+			t.Fatalf("expected severity of rule %s to be %q, got %q", r.Name(), wantSeverity, rc.Severity)
+		}
+
 		args := fmt.Sprintf("%v", rc.Arguments)
 		// Check rule args
 		switch r.Name() {
@@ -88,6 +114,14 @@ func TestLoadConfigParserPart(t *testing.T) {
 		t.Fatalf("unexpected conf file parsing error: %v", err)
 	}
 
+	if got.Parser.Format != "markdown" {
+		t.Fatalf("expected parser format to be markdown, got %s", got.Parser.Format)
+	}
+
+	if got.Parser.DateLayout != "02/01/2006" {
+		t.Fatalf("expected date layout to be 02/01/2006, got %s", got.Parser.DateLayout)
+	}
+
 	wantPattern := "title pattern"
 	gotPattern := got.Parser.Patterns.Title
 	if gotPattern != wantPattern {
@@ -113,6 +147,59 @@ func TestLoadConfigParserPart(t *testing.T) {
 	}
 }
 
+func TestLoadConfigVersioningPart(t *testing.T) {
+	got, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Versioning.Scheme != "semver" {
+		t.Fatalf("expected default versioning scheme to be semver, got %s", got.Versioning.Scheme)
+	}
+
+	got, err = LoadConfig("./testdata/versioning-conf.toml")
+	if err != nil {
+		t.Fatalf("unexpected conf file parsing error: %v", err)
+	}
+	scheme, err := got.VersioningScheme()
+	if err != nil || scheme.Name() != "calver:YYYY.MM.MICRO" {
+		t.Fatalf("expected versioning scheme to be calver:YYYY.MM.MICRO, got %v (error %v)", scheme, err)
+	}
+	if got.Parser.Patterns.Version != defaultPatternAnyVersion {
+		t.Fatalf("expected the version pattern of schemes other than semver, got %s", got.Parser.Patterns.Version)
+	}
+
+	// configured version patterns are kept
+	got, err = LoadConfig("./testdata/versioning-conf-pattern.toml")
+	if err != nil {
+		t.Fatalf("unexpected conf file parsing error: %v", err)
+	}
+	if got.Parser.Patterns.Version != "version pattern" {
+		t.Fatalf("expected pattern to be version pattern, got %s", got.Parser.Patterns.Version)
+	}
+
+	_, err = LoadConfig("./testdata/versioning-conf-bad-scheme.toml")
+	if err == nil || !strings.Contains(err.Error(), `unknown token "MONTH"`) {
+		t.Fatalf("expected bad versioning scheme error, got %v", err)
+	}
+}
+
+func TestChangelogParser(t *testing.T) {
+	conf, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p, err := conf.ChangelogParser()
+	if err != nil || p.Name() != "markdown" {
+		t.Fatalf("expected default parser to be markdown, got %v (error %v)", p, err)
+	}
+
+	conf.Parser.Format = "unknown"
+	if _, err := conf.ChangelogParser(); err == nil {
+		t.Fatalf("expected an error for an unknown changelog format")
+	}
+}
+
 func TestParserConfigErrors(t *testing.T) {
 	testCases := []struct {
 		file string
@@ -165,6 +252,10 @@ func TestParserConfig(t *testing.T) {
 		t.Fatalf("unexpected config error: %v ", err)
 	}
 
+	if got.DateLayout != "02/01/2006" {
+		t.Fatalf("expected date layout to be 02/01/2006, got %s", got.DateLayout)
+	}
+
 	wantPattern := "title pattern"
 	gotPattern := got.TitlePattern.String()
 	if gotPattern != wantPattern {
@@ -189,3 +280,20 @@ func TestParserConfig(t *testing.T) {
 		t.Fatalf("expected pattern to be %s, got %s", wantPattern, gotPattern)
 	}
 }
+
+func TestGetFormatter(t *testing.T) {
+	for _, name := range FormatterNames() {
+		f, err := GetFormatter(name)
+		if err != nil {
+			t.Fatalf("unexpected error retrieving formatter %q: %v", name, err)
+		}
+		if f.Name() != name {
+			t.Fatalf("expected formatter %q, got %q", name, f.Name())
+		}
+	}
+
+	_, err := GetFormatter("unknown")
+	if err == nil {
+		t.Fatal("expected unknown formatter error, got nothing")
+	}
+}
@@ -0,0 +1,197 @@
+// Package diff computes line-based unified diffs
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around changes
+const contextLines = 3
+
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+type op struct {
+	kind opKind
+	line string
+	a, b int // indexes of the line in the old and new texts
+}
+
+// Unified yields the unified diff between the texts a and b, named aName and bName in the diff headers.
+// It yields an empty string if both texts are equal.
+func Unified(aName, bName string, a, b []byte) string {
+	aLines, bLines := splitLines(string(a)), splitLines(string(b))
+	ops := diffLines(aLines, bLines)
+
+	var result strings.Builder
+	for _, hunk := range hunks(ops) {
+		if result.Len() == 0 {
+			fmt.Fprintf(&result, "--- %s\n+++ %s\n", aName, bName)
+		}
+		writeHunk(&result, hunk)
+	}
+
+	return result.String()
+}
+
+// splitLines splits text into lines, keeping line terminators
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines yields the shortest edit script transforming a into b (Myers' algorithm)
+func diffLines(a, b []string) []op {
+	n, m := len(a), len(b)
+	max := n + m
+	// v[k] is the furthest x reached on diagonal k, trace[d] is v for the edit distance d
+	v := map[int]int{1: 0}
+	trace := []map[int]int{}
+	for d := 0; d <= max; d++ {
+		snapshot := make(map[int]int, len(v))
+		for k, x := range v {
+			snapshot[k] = x
+		}
+		trace = append(trace, snapshot)
+
+		done := false
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1] < v[k+1]) {
+				x = v[k+1] // move down (insertion)
+			} else {
+				x = v[k-1] + 1 // move right (deletion)
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+		if done {
+			break
+		}
+	}
+
+	// backtrack from the end to build the edit script
+	ops := []op{}
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[k-1] < v[k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, op{kind: opEqual, line: a[x], a: x, b: y})
+		}
+
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, op{kind: opInsert, line: b[prevY], a: prevX, b: prevY})
+			} else {
+				ops = append(ops, op{kind: opDelete, line: a[prevX], a: prevX, b: prevY})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
+
+// hunks groups the edit script into hunks of changes surrounded by context lines
+func hunks(ops []op) [][]op {
+	result := [][]op{}
+	start, end := -1, -1 // bounds of the current hunk
+	for i, o := range ops {
+		if o.kind == opEqual {
+			continue
+		}
+
+		from := i - contextLines
+		if from < 0 {
+			from = 0
+		}
+		to := i + contextLines + 1
+		if to > len(ops) {
+			to = len(ops)
+		}
+
+		if start >= 0 && from > end {
+			result = append(result, ops[start:end])
+			start = -1
+		}
+		if start < 0 {
+			start = from
+		}
+		end = to
+	}
+
+	if start >= 0 {
+		result = append(result, ops[start:end])
+	}
+
+	return result
+}
+
+func writeHunk(w *strings.Builder, hunk []op) {
+	aStart, bStart := hunk[0].a, hunk[0].b
+	aLen, bLen := 0, 0
+	for _, o := range hunk {
+		switch o.kind {
+		case opEqual:
+			aLen++
+			bLen++
+		case opDelete:
+			aLen++
+		case opInsert:
+			bLen++
+		}
+	}
+
+	fmt.Fprintf(w, "@@ -%s +%s @@\n", hunkRange(aStart, aLen), hunkRange(bStart, bLen))
+	for _, o := range hunk {
+		w.WriteByte(byte(o.kind))
+		w.WriteString(o.line)
+		if !strings.HasSuffix(o.line, "\n") {
+			w.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange yields the range of a hunk header from the 0-based index of its first line
+func hunkRange(start, length int) string {
+	if length == 0 {
+		// empty ranges refer to the line before the change
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
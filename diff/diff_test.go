@@ -0,0 +1,61 @@
+package diff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want string
+	}{
+		{
+			a:    "a\nb\nc\n",
+			b:    "a\nb\nc\n",
+			want: "",
+		},
+		{
+			a: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			b: "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n",
+			want: `--- old
++++ new
+@@ -2,7 +2,7 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+`,
+		},
+		{
+			a: "a\nb",
+			b: "a\nb\nc\n",
+			want: `--- old
++++ new
+@@ -1,2 +1,3 @@
+ a
+-b
+\ No newline at end of file
++b
++c
+`,
+		},
+		{
+			a: "",
+			b: "a\n",
+			want: `--- old
++++ new
+@@ -0,0 +1,1 @@
++a
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		got := Unified("old", "new", []byte(tc.a), []byte(tc.b))
+		if got != tc.want {
+			t.Errorf("diff of %q and %q:\nexpected\n%s\ngot\n%s", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chavacava/changelog-lint/config"
+	"github.com/chavacava/changelog-lint/export"
+	"github.com/chavacava/changelog-lint/render"
+)
+
+// runExport writes the parsed changelog as a JSON or YAML document on the standard output
+func runExport(args []string) int {
+	flags := flag.NewFlagSet(args[0]+" export", flag.ExitOnError)
+	flagConfig := flags.String("config", "", "set linter configuration")
+	flagFormatIn := flags.String("format-in", "", "set the changelog format, overrides the configuration")
+	flagFormat := flags.String("format", export.FormatJSON, "set the document format ("+export.FormatJSON+", "+export.FormatYAML+")")
+
+	if err := flags.Parse(args[2:]); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	mainConfig, err := config.LoadConfig(*flagConfig)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if *flagFormatIn != "" {
+		mainConfig.Parser.Format = *flagFormatIn
+	}
+
+	p, err := mainConfig.ChangelogParser()
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	inputFilename := changelogFilename(flags.Args())
+	source, err := readChangelog(p, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	parse, err := newParseFunc(p, mainConfig, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	changes, err := parse(source)
+	if err != nil {
+		fmt.Println(err)
+		return codeSyntaxError
+	}
+
+	if err := export.New(*changes).Encode(os.Stdout, *flagFormat); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	return codeOK
+}
+
+// runImport renders a JSON or YAML document, read from a file or from the standard input, as a Markdown changelog
+// on the standard output
+func runImport(args []string) int {
+	flags := flag.NewFlagSet(args[0]+" import", flag.ExitOnError)
+	flagFormat := flags.String("format", export.FormatJSON, "set the document format ("+export.FormatJSON+", "+export.FormatYAML+")")
+
+	if err := flags.Parse(args[2:]); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	var input io.Reader = os.Stdin
+	if flags.NArg() > 0 && flags.Arg(0) != "-" {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			fmt.Println(err)
+			return codeRequestError
+		}
+		defer file.Close()
+		input = file
+	}
+
+	document, err := export.Decode(input, *flagFormat)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	if err := (render.Markdown{}).Render(os.Stdout, *document.Changelog()); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	return codeOK
+}
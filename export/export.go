@@ -0,0 +1,293 @@
+// Package export converts changelogs to and from documents serialized as JSON or YAML,
+// to feed release tooling and websites with changelog data, or to render generated data as a changelog.
+//
+// A document has the following schema (schema version 1), shown as YAML:
+//
+//	schemaVersion: 1          # version of the schema, documents of other versions are rejected
+//	format: markdown          # format of the changelog source, informative
+//	header:                   # lines before the first version, empty lines separate paragraphs
+//	  - Changelog
+//	versions:                 # from the newest to the oldest
+//	  - version: 1.2.0        # version name, Unreleased for unreleased changes
+//	    heading: "## [1.2.0] - 2022-11-19" # source line of the heading, built from the version and the date if absent
+//	    date: "2022-11-19"    # release date, when known
+//	    metadata:             # additional information, like the urgency of Debian releases or named groups of patterns
+//	      urgency: medium
+//	    range: {...}          # source range of the heading
+//	    subsections:
+//	      - name: Added
+//	        heading: "### Added" # source line of the heading, built from the name if absent
+//	        metadata: {...}   # named groups of the subsection pattern
+//	        range: {...}
+//	        entries:
+//	          - summary: "- New feature" # text of the entry on a single line
+//	            lines: ["- New feature"] # source lines of the entry, the summary is used if absent
+//	            metadata: {...} # named groups of the entry pattern
+//	            range: {...}
+//	links:                    # link reference definitions of Markdown changelogs, like the compare links of versions
+//	  - label: 1.2.0
+//	    url: https://github.com/owner/repo/compare/v1.1.0...v1.2.0
+//	    range: {...}
+//
+// Source ranges are made of a start and an end location, each one with a line and a column (starting at 1)
+// and a byte offset (starting at 0). They are absent from documents of changelogs without source, like change fragments,
+// and ignored when converting documents to changelogs.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+// SchemaVersion is the version of the schema of documents
+const SchemaVersion = 1
+
+// Serialization formats of documents
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Document is the serializable form of a changelog
+type Document struct {
+	SchemaVersion int       `json:"schemaVersion" yaml:"schemaVersion"`
+	Format        string    `json:"format,omitempty" yaml:"format,omitempty"`
+	Header        []string  `json:"header,omitempty" yaml:"header,omitempty"`
+	Versions      []Version `json:"versions" yaml:"versions"`
+	Links         []Link    `json:"links,omitempty" yaml:"links,omitempty"`
+}
+
+// Version is the serializable form of a version
+type Version struct {
+	Version     string            `json:"version" yaml:"version"`
+	Heading     string            `json:"heading,omitempty" yaml:"heading,omitempty"`
+	Date        string            `json:"date,omitempty" yaml:"date,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Range       *Range            `json:"range,omitempty" yaml:"range,omitempty"`
+	Subsections []Subsection      `json:"subsections" yaml:"subsections"`
+}
+
+// Subsection is the serializable form of a subsection, subsections without name gather entries of formats without subsections
+type Subsection struct {
+	Name     string            `json:"name" yaml:"name"`
+	Heading  string            `json:"heading,omitempty" yaml:"heading,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Range    *Range            `json:"range,omitempty" yaml:"range,omitempty"`
+	Entries  []Entry           `json:"entries" yaml:"entries"`
+}
+
+// Entry is the serializable form of an entry
+type Entry struct {
+	Summary  string            `json:"summary" yaml:"summary"`
+	Lines    []string          `json:"lines,omitempty" yaml:"lines,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Range    *Range            `json:"range,omitempty" yaml:"range,omitempty"`
+}
+
+// Link is the serializable form of a link reference definition
+type Link struct {
+	Label string `json:"label" yaml:"label"`
+	URL   string `json:"url" yaml:"url"`
+	Title string `json:"title,omitempty" yaml:"title,omitempty"`
+	Range *Range `json:"range,omitempty" yaml:"range,omitempty"`
+}
+
+// Range is the serializable form of a source range
+type Range struct {
+	Start Location `json:"start" yaml:"start"`
+	End   Location `json:"end" yaml:"end"`
+}
+
+// Location is the serializable form of a source location
+type Location struct {
+	Line   int `json:"line" yaml:"line"`
+	Column int `json:"column" yaml:"column"`
+	Offset int `json:"offset" yaml:"offset"`
+}
+
+// New builds the document of the given changelog
+func New(changes model.Changelog) Document {
+	result := Document{SchemaVersion: SchemaVersion, Format: changes.Format, Header: changes.Header, Versions: []Version{}}
+	for _, v := range changes.Versions {
+		result.Versions = append(result.Versions, NewVersion(*v))
+	}
+	for _, l := range changes.Links {
+		result.Links = append(result.Links, Link{Label: l.Label, URL: l.URL, Title: l.Title, Range: rangeOf(l.Position, l.Range)})
+	}
+
+	return result
+}
+
+// NewVersion builds the serializable form of the given version
+func NewVersion(v model.Version) Version {
+	result := Version{
+		Version:     v.Version,
+		Heading:     v.SourceLine,
+		Date:        v.Date,
+		Metadata:    metadataOf(v.Metadata),
+		Range:       rangeOf(v.Position, v.Range),
+		Subsections: []Subsection{},
+	}
+
+	for _, s := range v.Subsections {
+		subsection := Subsection{Name: s.Name, Heading: s.SourceLine, Metadata: metadataOf(s.Metadata), Range: rangeOf(s.Position, s.Range), Entries: []Entry{}}
+		for _, e := range s.History {
+			subsection.Entries = append(subsection.Entries, Entry{Summary: e.Summary, Lines: e.Lines, Metadata: metadataOf(e.Metadata), Range: rangeOf(e.Position, e.Range)})
+		}
+		result.Subsections = append(result.Subsections, subsection)
+	}
+
+	return result
+}
+
+// metadataOf yields the serializable form of metadata, nil for nodes without metadata
+func metadataOf(fields map[string]model.Field) map[string]string {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(fields))
+	for key, field := range fields {
+		result[key] = field.Value
+	}
+
+	return result
+}
+
+// fieldsOf yields the metadata of a node, nil for documents without metadata
+func fieldsOf(metadata map[string]string) map[string]model.Field {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	result := make(map[string]model.Field, len(metadata))
+	for key, value := range metadata {
+		result[key] = model.Field{Value: value}
+	}
+
+	return result
+}
+
+// rangeOf yields the serializable form of the range of a node at the given position, nil for nodes without position
+func rangeOf(position int, r model.Range) *Range {
+	if position <= 0 {
+		return nil
+	}
+
+	return &Range{
+		Start: Location{Line: r.Start.Line, Column: r.Start.Column, Offset: r.Start.Offset},
+		End:   Location{Line: r.End.Line, Column: r.End.Column, Offset: r.End.Offset},
+	}
+}
+
+// Changelog yields the changelog of the document.
+// Headings are built in the Markdown style when they are missing or when the changelog source is not Markdown,
+// and entries are unindented.
+func (d Document) Changelog() *model.Changelog {
+	result := model.NewChangelog()
+	result.Format = d.Format
+	result.Header = d.Header
+	markdown := d.Format == "" || d.Format == parser.Default{}.Name()
+	for _, v := range d.Versions {
+		version := &model.Version{Version: v.Version, SourceLine: v.Heading, Date: v.Date, Metadata: fieldsOf(v.Metadata)}
+		if version.SourceLine == "" || !markdown {
+			version.SourceLine = "## " + v.Version
+			if v.Version != "Unreleased" {
+				version.SourceLine = "## [" + v.Version + "]"
+			}
+			if v.Date != "" {
+				version.SourceLine += " - " + v.Date
+			}
+		}
+
+		for _, s := range v.Subsections {
+			subsection := &model.Subsection{Name: s.Name, SourceLine: s.Heading, Metadata: fieldsOf(s.Metadata)}
+			if (subsection.SourceLine == "" || !markdown) && s.Name != "" {
+				subsection.SourceLine = "### " + s.Name
+			}
+			for _, e := range s.Entries {
+				subsection.History = append(subsection.History, &model.Entry{Summary: e.Summary, Lines: unindent(e.Lines), Metadata: fieldsOf(e.Metadata)})
+			}
+			version.Subsections = append(version.Subsections, subsection)
+		}
+		result.Versions = append(result.Versions, version)
+	}
+	for _, l := range d.Links {
+		result.Links = append(result.Links, &model.Link{Label: l.Label, URL: l.URL, Title: l.Title})
+	}
+
+	return result
+}
+
+// Encode writes the document in the given format (json or yaml)
+func (d Document) Encode(w io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(d)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(d); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		return unknownFormatError(format)
+	}
+}
+
+// Decode reads a document in the given format (json or yaml)
+func Decode(r io.Reader, format string) (*Document, error) {
+	result := &Document{}
+	var err error
+	switch format {
+	case FormatJSON:
+		err = json.NewDecoder(r).Decode(result)
+	case FormatYAML:
+		err = yaml.NewDecoder(r).Decode(result)
+	default:
+		return nil, unknownFormatError(format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("bad %s document: %v", format, err)
+	}
+
+	if result.SchemaVersion != SchemaVersion {
+		return nil, fmt.Errorf("bad %s document: unsupported schema version %d (expected %d)", format, result.SchemaVersion, SchemaVersion)
+	}
+	for i, v := range result.Versions {
+		if strings.TrimSpace(v.Version) == "" {
+			return nil, fmt.Errorf("bad %s document: version %d has no name", format, i+1)
+		}
+	}
+
+	return result, nil
+}
+
+func unknownFormatError(format string) error {
+	return fmt.Errorf("unknown document format %q, available formats are: %s, %s", format, FormatJSON, FormatYAML)
+}
+
+// unindent removes the indentation of the first line from the given lines
+func unindent(lines []string) []string {
+	if len(lines) == 0 {
+		return lines
+	}
+
+	indent := lines[0][:len(lines[0])-len(strings.TrimLeft(lines[0], " \t"))]
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = strings.TrimPrefix(line, indent)
+	}
+
+	return result
+}
@@ -0,0 +1,127 @@
+package export_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/export"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+	"github.com/chavacava/changelog-lint/render"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestExport(t *testing.T) {
+	changes := parseMarkdown(t, "CHANGELOG.md")
+	for _, format := range []string{export.FormatJSON, export.FormatYAML} {
+		var encoded bytes.Buffer
+		if err := export.New(*changes).Encode(&encoded, format); err != nil {
+			t.Fatalf("%s: unexpected encoding error: %v", format, err)
+		}
+
+		goldenPath := filepath.Join("testdata", "CHANGELOG."+format)
+		if *update {
+			if err := os.WriteFile(goldenPath, encoded.Bytes(), 0o644); err != nil {
+				t.Fatalf("%s: unable to update golden file: %v", format, err)
+			}
+		}
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("%s: error reading golden file: %v", format, err)
+		}
+		if !bytes.Equal(encoded.Bytes(), want) {
+			t.Fatalf("%s: output does not match %s, got:\n%s", format, goldenPath, encoded.Bytes())
+		}
+
+		// importing the exported document yields the same changelog
+		document, err := export.Decode(&encoded, format)
+		if err != nil {
+			t.Fatalf("%s: unexpected decoding error: %v", format, err)
+		}
+		if got, want := renderMarkdown(t, document.Changelog()), renderMarkdown(t, changes); got != want {
+			t.Errorf("%s: expected imported changelog:\n%s\ngot:\n%s", format, want, got)
+		}
+	}
+}
+
+func TestImportWithoutHeadings(t *testing.T) {
+	source := `{"schemaVersion": 1, "format": "debian", "versions": [
+		{"version": "1.1.0", "heading": "pkg (1.1.0) unstable; urgency=low", "date": "2022-11-19", "subsections": [
+			{"name": "", "entries": [{"summary": "* Fix", "lines": ["  * Fix"]}]},
+			{"name": "Jane Doe", "heading": "  [ Jane Doe ]", "metadata": {"team": "core"}, "entries": [{"summary": "* Refactor", "metadata": {"issue": "12"}}]}
+		]},
+		{"version": "Unreleased", "subsections": []}
+	]}`
+
+	document, err := export.Decode(strings.NewReader(source), export.FormatJSON)
+	if err != nil {
+		t.Fatalf("unexpected decoding error: %v", err)
+	}
+
+	changes := document.Changelog()
+	want := "## [1.1.0] - 2022-11-19\n\n- Fix\n\n### Jane Doe\n\n- Refactor\n\n## Unreleased\n"
+	if got := renderMarkdown(t, changes); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	subsection := changes.Versions[0].Subsections[1]
+	if subsection.Metadata["team"].Value != "core" || subsection.History[0].Metadata["issue"].Value != "12" {
+		t.Errorf("expected metadata of subsections and entries to be imported, got %v and %v", subsection.Metadata, subsection.History[0].Metadata)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	testCases := map[string]struct {
+		format, source, want string
+	}{
+		"malformed":   {export.FormatJSON, `{"schemaVersion": 1, "versions": [`, "bad json document"},
+		"schema":      {export.FormatYAML, "schemaVersion: 42\nversions: []\n", "unsupported schema version 42"},
+		"unnamed":     {export.FormatYAML, "schemaVersion: 1\nversions:\n  - date: 2022-11-19\n", "version 1 has no name"},
+		"format":      {"toml", "", `unknown document format "toml"`},
+		"no version":  {export.FormatJSON, `{"versions": []}`, "unsupported schema version 0"},
+		"bad version": {export.FormatJSON, `{"schemaVersion": "1"}`, "bad json document"},
+	}
+
+	for name, tc := range testCases {
+		_, err := export.Decode(strings.NewReader(tc.source), tc.format)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.want, err)
+		}
+	}
+}
+
+func parseMarkdown(t *testing.T, name string) *model.Changelog {
+	t.Helper()
+	source, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+
+	changes, err := parser.Default{}.Parse(bytes.NewReader(source), &parser.Config{
+		TitlePattern:      regexp.MustCompile(`.+`),
+		VersionPattern:    regexp.MustCompile(`^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$`),
+		SubsectionPattern: regexp.MustCompile(`^### ([A-Z]+[a-z]+)[ ]*$`),
+		EntryPattern:      regexp.MustCompile(`^[*-] .+$`),
+	})
+	if err != nil {
+		t.Fatalf("%s: unexpected parsing error: %v", name, err)
+	}
+
+	return changes
+}
+
+func renderMarkdown(t *testing.T, changes *model.Changelog) string {
+	t.Helper()
+	var result bytes.Buffer
+	if err := (render.Markdown{}).Render(&result, *changes); err != nil {
+		t.Fatalf("unexpected rendering error: %v", err)
+	}
+
+	return result.String()
+}
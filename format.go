@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/chavacava/changelog-lint/config"
+	"github.com/chavacava/changelog-lint/parser"
+	"github.com/chavacava/changelog-lint/render"
+)
+
+// runFormat rewrites the changelog in a normalized style
+func runFormat(args []string) int {
+	flags := flag.NewFlagSet(args[0]+" fmt", flag.ExitOnError)
+	flagConfig := flags.String("config", "", "set linter configuration")
+	flagCheck := flags.Bool("check", false, "do not rewrite the changelog, fail if it is not formatted")
+
+	if err := flags.Parse(args[2:]); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	mainConfig, err := config.LoadConfig(*flagConfig)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	p, err := mainConfig.ChangelogParser()
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if p.Name() != (parser.Default{}).Name() {
+		fmt.Printf("unable to format %s changelogs, only %s ones are supported\n", p.Name(), parser.Default{}.Name())
+		return codeRequestError
+	}
+
+	inputFilename := changelogFilename(flags.Args())
+	source, err := os.ReadFile(inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	parse, err := newParseFunc(p, mainConfig, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	changes, err := parse(source)
+	if err != nil {
+		fmt.Println(err)
+		return codeSyntaxError
+	}
+
+	var formatted bytes.Buffer
+	if err := (render.Markdown{}).Render(&formatted, *changes); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	if bytes.Equal(formatted.Bytes(), source) {
+		return codeOK
+	}
+
+	if *flagCheck {
+		fmt.Printf("%s is not formatted\n", inputFilename)
+		return codeLintError
+	}
+
+	if err := writeFile(inputFilename, formatted.Bytes()); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	return codeOK
+}
@@ -0,0 +1,72 @@
+package formatter
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// Checkstyle formats reports as Checkstyle XML documents
+type Checkstyle struct{}
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func (Checkstyle) Format(w io.Writer, report Report) error {
+	file := checkstyleFile{Name: report.Filename}
+	for _, syntaxError := range report.SyntaxErrors {
+		file.Errors = append(file.Errors, checkstyleError{
+			Line:     syntaxError.Position,
+			Column:   syntaxError.Range.Start.Column,
+			Severity: "error",
+			Message:  syntaxError.Message,
+			Source:   toolName + "." + syntaxErrorRule.ID,
+		})
+	}
+
+	for _, failure := range report.Failures {
+		file.Errors = append(file.Errors, checkstyleError{
+			Line:     failure.Position,
+			Column:   failure.Range.Start.Column,
+			Severity: string(severityOf(failure)),
+			Message:  failure.Message,
+			Source:   toolName + "." + failure.RuleName,
+		})
+	}
+
+	return writeXML(w, checkstyleReport{Version: "5.0", Files: []checkstyleFile{file}})
+}
+
+func (Checkstyle) Name() string {
+	return "checkstyle"
+}
+
+func writeXML(w io.Writer, document any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
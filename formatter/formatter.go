@@ -0,0 +1,33 @@
+// Package formatter holds the formatters of linting reports
+package formatter
+
+import (
+	"io"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+// Report is the outcome of linting a changelog file
+type Report struct {
+	Filename     string
+	SyntaxErrors parser.SyntaxErrors
+	Failures     []linting.Failure
+	Rules        []linting.Rule  // all available rules
+	EnabledRules map[string]bool // names of the rules applied to the changelog
+}
+
+// severityOf yields the severity of the failure, failures without severity are errors
+func severityOf(failure linting.Failure) linting.Severity {
+	if failure.Severity == "" {
+		return linting.SeverityError
+	}
+
+	return failure.Severity
+}
+
+// Formatter general abstraction
+type Formatter interface {
+	Format(w io.Writer, report Report) error
+	Name() string
+}
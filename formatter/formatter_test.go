@@ -0,0 +1,112 @@
+package formatter_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/formatter"
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/linting/rule"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestFormatters(t *testing.T) {
+	testCases := []struct {
+		formatter formatter.Formatter
+		golden    string
+	}{
+		{formatter.Text{}, "report.txt"},
+		{formatter.JSON{}, "report.json"},
+		{formatter.SARIF{}, "report.sarif"},
+		{formatter.Checkstyle{}, "report.checkstyle.xml"},
+		{formatter.JUnit{}, "report.junit.xml"},
+		{formatter.GitHub{}, "report.github.txt"},
+		{formatter.GitLab{}, "report.gitlab.json"},
+	}
+
+	for _, tc := range testCases {
+		var got bytes.Buffer
+		if err := tc.formatter.Format(&got, sampleReport()); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.formatter.Name(), err)
+		}
+
+		goldenPath := filepath.Join("testdata", tc.golden)
+		if *update {
+			if err := os.WriteFile(goldenPath, got.Bytes(), 0o644); err != nil {
+				t.Fatalf("%s: unable to update golden file: %v", tc.formatter.Name(), err)
+			}
+		}
+
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("%s: error reading golden file: %v", tc.formatter.Name(), err)
+		}
+
+		if !bytes.Equal(got.Bytes(), want) {
+			t.Fatalf("%s: output does not match %s, got:\n%s", tc.formatter.Name(), goldenPath, got.String())
+		}
+	}
+}
+
+func lineRange(line, startColumn, endColumn, lineOffset int) model.Range {
+	return model.Range{
+		Start: model.Location{Line: line, Column: startColumn, Offset: lineOffset + startColumn - 1},
+		End:   model.Location{Line: line, Column: endColumn, Offset: lineOffset + endColumn - 1},
+	}
+}
+
+func sampleReport() formatter.Report {
+	return formatter.Report{
+		Filename: "CHANGELOG.md",
+		SyntaxErrors: parser.SyntaxErrors{
+			{
+				Message:  "unexpected line:some text\nexpecting subsection or version",
+				Position: 4,
+				Range:    lineRange(4, 1, 10, 30),
+			},
+		},
+		Failures: []linting.Failure{
+			{
+				RuleName: "release",
+				Message:  "expected release version argument to be a string, got <nil> instead",
+			},
+			{
+				RuleName: "version-empty",
+				Message:  `empty version "1.1.0"`,
+				Position: 6,
+				Range:    lineRange(6, 1, 12, 45),
+				Node:     "1.1.0",
+				Severity: linting.SeverityWarning,
+			},
+			{
+				RuleName: "subsection-naming",
+				Message:  `unknown subsection "Addeda" in version 1.0.0`,
+				Position: 9,
+				Range:    lineRange(9, 5, 11, 70),
+				Node:     "1.0.0/Addeda",
+				Severity: linting.SeverityInfo,
+			},
+			{
+				RuleName: "unused-suppression",
+				Message:  "changelog-lint-disable directive does not suppress any failure of version-order",
+				Position: 2,
+				Range:    lineRange(2, 1, 45, 12),
+				Severity: linting.SeverityWarning,
+			},
+			{
+				RuleName: "stale-baseline",
+				Message:  `recorded failure of rule version-empty no longer occurs: empty version "0.9.0"`,
+				Node:     "0.9.0",
+				Severity: linting.SeverityWarning,
+			},
+		},
+		Rules:        []linting.Rule{rule.SubsectionNaming{}, rule.VersionEmpty{}, rule.VersionOrder{}, rule.Release{}},
+		EnabledRules: map[string]bool{"subsection-naming": true, "version-empty": true, "release": true},
+	}
+}
@@ -0,0 +1,72 @@
+package formatter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// GitHub formats reports as GitHub Actions workflow commands
+// to make failures appear as annotations of pull requests
+type GitHub struct{}
+
+var (
+	githubDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+func (GitHub) Format(w io.Writer, report Report) error {
+	for _, syntaxError := range report.SyntaxErrors {
+		if err := writeGitHubCommand(w, "error", report.Filename, syntaxError.Position, syntaxError.Range, syntaxErrorRule.ID, syntaxError.Message); err != nil {
+			return err
+		}
+	}
+
+	for _, failure := range report.Failures {
+		if err := writeGitHubCommand(w, githubCommand(severityOf(failure)), report.Filename, failure.Position, failure.Range, failure.RuleName, failure.Message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (GitHub) Name() string {
+	return "github"
+}
+
+func githubCommand(severity linting.Severity) string {
+	switch severity {
+	case linting.SeverityWarning:
+		return "warning"
+	case linting.SeverityInfo:
+		return "notice"
+	default:
+		return "error"
+	}
+}
+
+func writeGitHubCommand(w io.Writer, command, filename string, line int, r model.Range, title, msg string) error {
+	properties := []string{"file=" + githubPropertyEscaper.Replace(filename)}
+	switch {
+	case !r.IsZero():
+		properties = append(properties,
+			fmt.Sprintf("line=%d", r.Start.Line),
+			fmt.Sprintf("col=%d", r.Start.Column),
+			fmt.Sprintf("endLine=%d", r.End.Line),
+		)
+		if r.Start.Line == r.End.Line {
+			// GitHub only supports end columns for single line annotations
+			properties = append(properties, fmt.Sprintf("endColumn=%d", r.End.Column))
+		}
+	case line > 0:
+		properties = append(properties, fmt.Sprintf("line=%d", line))
+	}
+	properties = append(properties, "title="+githubPropertyEscaper.Replace(title))
+
+	_, err := fmt.Fprintf(w, "::%s %s::%s\n", command, strings.Join(properties, ","), githubDataEscaper.Replace(msg))
+	return err
+}
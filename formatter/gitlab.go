@@ -0,0 +1,106 @@
+package formatter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/chavacava/changelog-lint/linting"
+)
+
+// GitLab formats reports as GitLab Code Quality reports
+type GitLab struct{}
+
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+}
+
+func (GitLab) Format(w io.Writer, report Report) error {
+	issues := make([]gitlabIssue, 0, len(report.SyntaxErrors)+len(report.Failures))
+	fingerprints := gitlabFingerprints{}
+	for _, syntaxError := range report.SyntaxErrors {
+		issues = append(issues, gitlabIssue{
+			Description: syntaxError.Message,
+			CheckName:   syntaxErrorRule.ID,
+			Fingerprint: fingerprints.next(syntaxErrorRule.ID, report.Filename, syntaxError.Message),
+			Severity:    "blocker",
+			Location:    newGitLabLocation(report.Filename, syntaxError.Position),
+		})
+	}
+
+	for _, failure := range report.Failures {
+		// the node identifies the failure independently of its position,
+		// failures not related to a node are identified by their message
+		node := failure.Node
+		if node == "" {
+			node = failure.Message
+		}
+		issues = append(issues, gitlabIssue{
+			Description: failure.Message,
+			CheckName:   failure.RuleName,
+			Fingerprint: fingerprints.next(failure.RuleName, report.Filename, node),
+			Severity:    gitlabSeverity(severityOf(failure)),
+			Location:    newGitLabLocation(report.Filename, failure.Position),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(issues)
+}
+
+func (GitLab) Name() string {
+	return "gitlab"
+}
+
+// gitlabFingerprints counts the occurrences of each issue to keep fingerprints unique
+type gitlabFingerprints map[string]int
+
+// next yields a fingerprint that does not depend on the position of the issue
+// thus moving lines in the changelog does not create new issues
+func (f gitlabFingerprints) next(ruleName, filename, node string) string {
+	key := ruleName + "\x00" + filename + "\x00" + node
+	occurrence := f[key]
+	f[key]++
+	if occurrence > 0 {
+		key += "\x00" + strconv.Itoa(occurrence)
+	}
+
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func gitlabSeverity(severity linting.Severity) string {
+	switch severity {
+	case linting.SeverityWarning:
+		return "minor"
+	case linting.SeverityInfo:
+		return "info"
+	default:
+		return "major"
+	}
+}
+
+func newGitLabLocation(filename string, line int) gitlabLocation {
+	if line < 1 {
+		// GitLab requires a line number
+		line = 1
+	}
+
+	return gitlabLocation{Path: filename, Lines: gitlabLines{Begin: line}}
+}
@@ -0,0 +1,97 @@
+package formatter
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// JSON formats reports as a JSON document
+type JSON struct{}
+
+type jsonLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Offset int `json:"offset"`
+}
+
+type jsonRange struct {
+	Start jsonLocation `json:"start"`
+	End   jsonLocation `json:"end"`
+}
+
+type jsonSyntaxError struct {
+	Message string     `json:"message"`
+	Line    int        `json:"line"`
+	Range   *jsonRange `json:"range,omitempty"`
+}
+
+type jsonFailure struct {
+	Rule     string     `json:"rule"`
+	Message  string     `json:"message"`
+	Severity string     `json:"severity"`
+	Line     int        `json:"line,omitempty"`
+	Range    *jsonRange `json:"range,omitempty"`
+}
+
+type jsonSummary struct {
+	SyntaxErrors int `json:"syntaxErrors"`
+	Failures     int `json:"failures"`
+}
+
+type jsonReport struct {
+	File         string            `json:"file"`
+	SyntaxErrors []jsonSyntaxError `json:"syntaxErrors"`
+	Failures     []jsonFailure     `json:"failures"`
+	Summary      jsonSummary       `json:"summary"`
+}
+
+func (JSON) Format(w io.Writer, report Report) error {
+	result := jsonReport{
+		File:         report.Filename,
+		SyntaxErrors: make([]jsonSyntaxError, 0, len(report.SyntaxErrors)),
+		Failures:     make([]jsonFailure, 0, len(report.Failures)),
+		Summary: jsonSummary{
+			SyntaxErrors: len(report.SyntaxErrors),
+			Failures:     len(report.Failures),
+		},
+	}
+
+	for _, syntaxError := range report.SyntaxErrors {
+		result.SyntaxErrors = append(result.SyntaxErrors, jsonSyntaxError{
+			Message: syntaxError.Message,
+			Line:    syntaxError.Position,
+			Range:   newJSONRange(syntaxError.Range),
+		})
+	}
+
+	for _, failure := range report.Failures {
+		result.Failures = append(result.Failures, jsonFailure{
+			Rule:     failure.RuleName,
+			Message:  failure.Message,
+			Severity: string(severityOf(failure)),
+			Line:     failure.Position,
+			Range:    newJSONRange(failure.Range),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+func (JSON) Name() string {
+	return "json"
+}
+
+func newJSONRange(r model.Range) *jsonRange {
+	if r.IsZero() {
+		return nil
+	}
+
+	return &jsonRange{
+		Start: jsonLocation{Line: r.Start.Line, Column: r.Start.Column, Offset: r.Start.Offset},
+		End:   jsonLocation{Line: r.End.Line, Column: r.End.Column, Offset: r.End.Offset},
+	}
+}
@@ -0,0 +1,121 @@
+package formatter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// JUnit formats reports as JUnit XML documents, with one test case per rule
+type JUnit struct{}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+func (JUnit) Format(w io.Writer, report Report) error {
+	suite := junitTestSuite{Name: report.Filename}
+
+	syntaxMessages := make([]string, len(report.SyntaxErrors))
+	for i, syntaxError := range report.SyntaxErrors {
+		syntaxMessages[i] = syntaxError.Error()
+	}
+	suite.Cases = append(suite.Cases, newJUnitTestCase(report.Filename, syntaxErrorRule.ID, syntaxMessages))
+
+	failureMessages := map[string][]string{}
+	failingRules := []string{} // in order of first failure
+	for _, failure := range report.Failures {
+		msg := fmt.Sprintf("[%s] %s", severityOf(failure), failure.Message)
+		if failure.Position > 0 {
+			msg = fmt.Sprintf("%s (line %d)", msg, failure.Position)
+		}
+		if _, ok := failureMessages[failure.RuleName]; !ok {
+			failingRules = append(failingRules, failure.RuleName)
+		}
+		failureMessages[failure.RuleName] = append(failureMessages[failure.RuleName], msg)
+	}
+
+	reportedRules := map[string]bool{}
+	for _, r := range report.Rules {
+		testCase := newJUnitTestCase(report.Filename, r.Name(), failureMessages[r.Name()])
+		if !report.EnabledRules[r.Name()] {
+			testCase.Skipped = &junitSkipped{Message: "rule disabled"}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+		reportedRules[r.Name()] = true
+	}
+
+	// failures of the linter itself, like unused suppressions, have no rule in the report
+	for _, ruleName := range failingRules {
+		if !reportedRules[ruleName] {
+			suite.Cases = append(suite.Cases, newJUnitTestCase(report.Filename, ruleName, failureMessages[ruleName]))
+		}
+	}
+
+	for _, testCase := range suite.Cases {
+		suite.Tests++
+		switch {
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		case testCase.Failure != nil:
+			suite.Failures++
+		}
+	}
+
+	return writeXML(w, junitTestSuites{
+		Name:     toolName,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Suites:   []junitTestSuite{suite},
+	})
+}
+
+func (JUnit) Name() string {
+	return "junit"
+}
+
+// newJUnitTestCase yields a test case that fails if the given failure messages are not empty
+func newJUnitTestCase(filename, ruleName string, failureMessages []string) junitTestCase {
+	result := junitTestCase{Name: ruleName, ClassName: filename}
+	if len(failureMessages) > 0 {
+		result.Failure = &junitFailure{
+			Message: fmt.Sprintf("%d failure(s)", len(failureMessages)),
+			Type:    ruleName,
+			Text:    strings.Join(failureMessages, "\n"),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,193 @@
+package formatter
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// SARIF formats reports as SARIF 2.1.0 logs
+type SARIF struct{}
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "changelog-lint"
+	toolURI      = "https://github.com/chavacava/changelog-lint"
+)
+
+// syntaxErrorRule is the pseudo-rule under which syntax errors are reported
+var syntaxErrorRule = sarifRule{
+	ID:               "syntax-error",
+	Name:             "syntax-error",
+	ShortDescription: &sarifMessage{Text: "The changelog must comply with the expected syntax."},
+	Help:             &sarifMessage{Text: "Fix the line to make it match the format expected by the parser."},
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	Help             *sarifMessage `json:"help,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+func (SARIF) Format(w io.Writer, report Report) error {
+	rules := []sarifRule{syntaxErrorRule}
+	ruleIndexes := map[string]int{syntaxErrorRule.ID: 0}
+	for _, r := range report.Rules {
+		ruleIndexes[r.Name()] = len(rules)
+		rules = append(rules, newSARIFRule(r))
+	}
+
+	results := make([]sarifResult, 0, len(report.SyntaxErrors)+len(report.Failures))
+	for _, syntaxError := range report.SyntaxErrors {
+		results = append(results, sarifResult{
+			RuleID:    syntaxErrorRule.ID,
+			RuleIndex: 0,
+			Level:     "error",
+			Message:   sarifMessage{Text: syntaxError.Message},
+			Locations: newSARIFLocations(report.Filename, syntaxError.Position, syntaxError.Range),
+		})
+	}
+
+	for _, failure := range report.Failures {
+		ruleIndex, ok := ruleIndexes[failure.RuleName]
+		if !ok {
+			ruleIndexes[failure.RuleName] = len(rules)
+			ruleIndex = len(rules)
+			rules = append(rules, sarifRule{ID: failure.RuleName, Name: failure.RuleName})
+		}
+		results = append(results, sarifResult{
+			RuleID:    failure.RuleName,
+			RuleIndex: ruleIndex,
+			Level:     sarifLevel(severityOf(failure)),
+			Message:   sarifMessage{Text: failure.Message},
+			Locations: newSARIFLocations(report.Filename, failure.Position, failure.Range),
+		})
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           toolName,
+						InformationURI: toolURI,
+						Rules:          rules,
+					},
+				},
+				ColumnKind: "unicodeCodePoints",
+				Results:    results,
+			},
+		},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+func (SARIF) Name() string {
+	return "sarif"
+}
+
+func sarifLevel(severity linting.Severity) string {
+	switch severity {
+	case linting.SeverityWarning:
+		return "warning"
+	case linting.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+func newSARIFRule(r linting.Rule) sarifRule {
+	result := sarifRule{ID: r.Name(), Name: r.Name()}
+	if doc, ok := r.(linting.Documented); ok {
+		result.ShortDescription = &sarifMessage{Text: doc.Description()}
+		result.Help = &sarifMessage{Text: doc.Help()}
+	}
+
+	return result
+}
+
+func newSARIFLocations(filename string, line int, r model.Range) []sarifLocation {
+	location := sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: filename},
+		},
+	}
+
+	switch {
+	case !r.IsZero():
+		location.PhysicalLocation.Region = &sarifRegion{
+			StartLine:   r.Start.Line,
+			StartColumn: r.Start.Column,
+			EndLine:     r.End.Line,
+			EndColumn:   r.End.Column,
+		}
+	case line > 0:
+		location.PhysicalLocation.Region = &sarifRegion{StartLine: line}
+	}
+
+	return []sarifLocation{location}
+}
@@ -0,0 +1,33 @@
+package formatter
+
+import (
+	"fmt"
+	"io"
+)
+
+// Text formats reports as plain text, one line per syntax error or failure
+type Text struct{}
+
+func (Text) Format(w io.Writer, report Report) error {
+	for _, syntaxError := range report.SyntaxErrors {
+		if _, err := fmt.Fprintln(w, syntaxError); err != nil {
+			return err
+		}
+	}
+
+	for _, failure := range report.Failures {
+		lineInfo := ""
+		if failure.Position > 0 {
+			lineInfo = fmt.Sprintf("(line %d)", failure.Position)
+		}
+		if _, err := fmt.Fprintf(w, "%s [%s]: %s %s\n", failure.RuleName, severityOf(failure), failure.Message, lineInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (Text) Name() string {
+	return "text"
+}
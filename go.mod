@@ -2,4 +2,7 @@ module github.com/chavacava/changelog-lint
 
 go 1.19
 
-require github.com/BurntSushi/toml v1.2.1
+require (
+	github.com/BurntSushi/toml v1.2.1
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -1,5 +1,16 @@
 package linting
 
 type Config struct {
-	RuleArgs map[Rule]RuleArgs
+	RuleArgs       map[Rule]RuleArgs
+	RuleSeverities map[Rule]Severity // rules without a severity have SeverityError
+}
+
+// severity yields the severity of failures of the given rule
+func (c Config) severity(r Rule) Severity {
+	severity, ok := c.RuleSeverities[r]
+	if !ok || severity == "" {
+		return SeverityError
+	}
+
+	return severity
 }
@@ -1,8 +1,23 @@
 package linting
 
+import (
+	"strings"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
 // Failure is the linting error model
 type Failure struct {
 	RuleName string
 	Message  string
-	Position int // line number in the changelog file
+	Position int         // line number in the changelog file
+	Range    model.Range // source range of the failure, zero if the failure is not related to a specific node
+	Node     string      // identifier of the node of the failure, independent of its position (see NodeID)
+	Severity Severity    // set by the linter from the configuration of the rule
+}
+
+// NodeID yields an identifier of a changelog node from its name and the names of its ancestors.
+// For example, the identifier of the subsection "Added" of version 1.2.0 is NodeID("1.2.0", "Added")
+func NodeID(names ...string) string {
+	return strings.Join(names, "/")
 }
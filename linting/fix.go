@@ -0,0 +1,132 @@
+package linting
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// maxFixPasses bounds the number of fix passes to avoid looping forever on conflicting fixes
+const maxFixPasses = 10
+
+// Edit replaces the source bytes between the Start (inclusive) and End (exclusive) offsets with NewText
+type Edit struct {
+	Start   int
+	End     int
+	NewText string
+}
+
+// Fixer is implemented by rules able to fix the failures they detect.
+// Fix yields the edits to apply on the source of the changelog.
+type Fixer interface {
+	Fix(changes model.Changelog, source []byte, args RuleArgs) []Edit
+}
+
+// ApplyEdits applies the given edits on the source.
+// Edits overlapping a previously applied one and edits not changing the source are discarded,
+// it returns the new source and the number of applied edits.
+func ApplyEdits(source []byte, edits []Edit) ([]byte, int) {
+	sorted := append([]Edit{}, edits...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	result := make([]byte, 0, len(source))
+	applied := 0
+	lastEnd := 0
+	lastIsInsertion := false
+	for _, e := range sorted {
+		isInsertion := e.Start == e.End
+		overlaps := e.Start < lastEnd || (applied > 0 && e.Start == lastEnd && (isInsertion || lastIsInsertion))
+		if overlaps || e.Start > e.End || e.End > len(source) {
+			continue
+		}
+
+		if string(source[e.Start:e.End]) == e.NewText {
+			continue // nothing to change
+		}
+
+		result = append(result, source[lastEnd:e.Start]...)
+		result = append(result, e.NewText...)
+		lastEnd = e.End
+		lastIsInsertion = isInsertion
+		applied++
+	}
+	result = append(result, source[lastEnd:]...)
+
+	return result, applied
+}
+
+// Fix applies the fixes of the configured rules on the source of a changelog
+// until there is nothing left to fix. Fixes are applied in passes because
+// conflicting (overlapping) edits can only be applied one at a time.
+// The parse function is used to get the changelog of the source at each pass,
+// the fixes of a rule are discarded if the changelog does not parse anymore once they are applied.
+func (Linter) Fix(source []byte, parse func([]byte) (*model.Changelog, error), config *Config) ([]byte, error) {
+	// apply fixers in a stable order
+	rules := make([]Rule, 0, len(config.RuleArgs))
+	for r := range config.RuleArgs {
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name() < rules[j].Name() })
+
+	for pass := 0; pass < maxFixPasses; pass++ {
+		changes, err := parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fix the changelog: %v", err)
+		}
+
+		suppressions := newSuppressions(changes.Directives)
+		edits := []Edit{}
+		newSource, applied := source, 0
+		for _, r := range rules {
+			fixer, ok := r.(Fixer)
+			if !ok {
+				continue
+			}
+			ruleEdits := []Edit{}
+			for _, e := range fixer.Fix(*changes, source, config.RuleArgs[r]) {
+				// do not touch lines where the rule is disabled
+				if suppressions.overlap(r.Name(), lineOf(source, e.Start), lineOf(source, e.End)) {
+					continue
+				}
+				ruleEdits = append(ruleEdits, e)
+			}
+			if len(ruleEdits) == 0 {
+				continue
+			}
+
+			candidateEdits := append(append([]Edit{}, edits...), ruleEdits...)
+			candidate, candidateApplied := ApplyEdits(source, candidateEdits)
+			if _, err := parse(candidate); err != nil {
+				continue // the fix breaks the changelog, e.g. it moves an empty version at the end of the file
+			}
+			edits, newSource, applied = candidateEdits, candidate, candidateApplied
+		}
+
+		if applied == 0 {
+			break
+		}
+		source = newSource
+	}
+
+	return source, nil
+}
+
+// LineEnding yields the line ending of the source: \r\n if its first line ends with it, \n otherwise
+func LineEnding(source []byte) string {
+	if i := bytes.IndexByte(source, '\n'); i > 0 && source[i-1] == '\r' {
+		return "\r\n"
+	}
+
+	return "\n"
+}
+
+// lineOf yields the line number of the given offset of the source
+func lineOf(source []byte, offset int) int {
+	if offset > len(source) {
+		offset = len(source)
+	}
+
+	return bytes.Count(source[:offset], []byte("\n")) + 1
+}
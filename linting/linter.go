@@ -13,13 +13,39 @@ type Rule interface {
 	Name() string
 }
 
+// Documented is implemented by rules providing documentation about themselves
+type Documented interface {
+	Description() string // what the rule checks
+	Help() string        // how to fix the failures of the rule
+}
+
 // Linter provides changelog linting method
 type Linter struct{}
 
-// Lint a changelog
+// Lint a changelog.
+// Failures suppressed by directives of the changelog are discarded
+// and directives not suppressing any failure are reported as warnings.
 func (Linter) Lint(changes model.Changelog, config *Config, failures chan Failure) {
+	suppressions := newSuppressions(changes.Directives)
 	for rule, rConf := range config.RuleArgs {
-		rule.Apply(changes, failures, rConf)
+		ruleFailures := make(chan Failure)
+		go func(rule Rule, rConf RuleArgs) {
+			rule.Apply(changes, ruleFailures, rConf)
+			close(ruleFailures)
+		}(rule, rConf)
+
+		severity := config.severity(rule)
+		for failure := range ruleFailures {
+			if suppressions.suppress(failure) {
+				continue
+			}
+			failure.Severity = severity
+			failures <- failure
+		}
+	}
+
+	for _, failure := range suppressions.unused() {
+		failures <- failure
 	}
 	close(failures)
 }
@@ -0,0 +1,167 @@
+package linting_test
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// lineRule fails on every line given as argument
+type lineRule struct{ name string }
+
+func (r lineRule) Name() string { return r.name }
+
+func (r lineRule) Apply(_ model.Changelog, failures chan linting.Failure, args linting.RuleArgs) {
+	for _, line := range args.([]int) {
+		failures <- linting.Failure{
+			RuleName: r.name,
+			Message:  "failure",
+			Position: line,
+		}
+	}
+}
+
+func TestLintSuppressions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		directives []*model.Directive
+		want       []string
+	}{
+		{
+			name: "no directives",
+			want: []string{"a:3", "a:5", "a:9", "b:5"},
+		},
+		{
+			name: "disable next line",
+			directives: []*model.Directive{
+				{Kind: model.DirectiveDisableNextLine, Rules: []string{"a"}, Position: 4},
+			},
+			want: []string{"a:3", "a:9", "b:5"},
+		},
+		{
+			name: "disable all rules until enabled",
+			directives: []*model.Directive{
+				{Kind: model.DirectiveDisable, Position: 4},
+				{Kind: model.DirectiveEnable, Position: 6},
+			},
+			want: []string{"a:3", "a:9"},
+		},
+		{
+			name: "disable until the end",
+			directives: []*model.Directive{
+				{Kind: model.DirectiveDisable, Rules: []string{"a"}, Position: 4},
+				{Kind: model.DirectiveEnable, Rules: []string{"b"}, Position: 6},
+			},
+			want: []string{"a:3", "b:5"},
+		},
+		{
+			name: "unused suppressions",
+			directives: []*model.Directive{
+				{Kind: model.DirectiveDisableNextLine, Rules: []string{"a", "b"}, Position: 2},
+				{Kind: model.DirectiveDisable, Rules: []string{"c"}, Position: 7},
+			},
+			want: []string{"a:5", "a:9", "b:5", "unused-suppression:2", "unused-suppression:7"},
+		},
+	}
+
+	for _, tc := range testCases {
+		config := &linting.Config{
+			RuleArgs: map[linting.Rule]linting.RuleArgs{
+				lineRule{"a"}: []int{3, 5, 9},
+				lineRule{"b"}: []int{5},
+			},
+		}
+		failures := make(chan linting.Failure)
+		go linting.Linter{}.Lint(model.Changelog{Directives: tc.directives}, config, failures)
+
+		got := []string{}
+		for f := range failures {
+			got = append(got, f.RuleName+":"+strconv.Itoa(f.Position))
+			if f.RuleName == "unused-suppression" && f.Severity != linting.SeverityWarning {
+				t.Errorf("%s: expected unused suppressions to be warnings, got %s", tc.name, f.Severity)
+			}
+		}
+		sort.Strings(got)
+
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Errorf("%s: expected failures %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestApplyEdits(t *testing.T) {
+	source := []byte("abcdef")
+	testCases := []struct {
+		name    string
+		edits   []linting.Edit
+		want    string
+		applied int
+	}{
+		{"no edits", nil, "abcdef", 0},
+		{"unordered edits", []linting.Edit{{Start: 4, End: 5, NewText: "E"}, {Start: 0, End: 1, NewText: "A"}}, "AbcdEf", 2},
+		{"overlapping edits", []linting.Edit{{Start: 1, End: 3, NewText: "X"}, {Start: 2, End: 4, NewText: "Y"}}, "aXdef", 1},
+		{"insertions at the same offset", []linting.Edit{{Start: 2, End: 2, NewText: "X"}, {Start: 2, End: 2, NewText: "Y"}}, "abXcdef", 1},
+		{"no-op edit", []linting.Edit{{Start: 1, End: 2, NewText: "b"}}, "abcdef", 0},
+	}
+
+	for _, tc := range testCases {
+		got, applied := linting.ApplyEdits(source, tc.edits)
+		if string(got) != tc.want || applied != tc.applied {
+			t.Errorf("%s: expected %q with %d edits applied, got %q with %d", tc.name, tc.want, tc.applied, got, applied)
+		}
+	}
+}
+
+// editRule is a rule which fix replaces the source with its argument
+type editRule struct{ name string }
+
+func (r editRule) Name() string { return r.name }
+
+func (editRule) Apply(model.Changelog, chan linting.Failure, linting.RuleArgs) {}
+
+func (editRule) Fix(_ model.Changelog, source []byte, args linting.RuleArgs) []linting.Edit {
+	return []linting.Edit{{Start: 0, End: len(source), NewText: args.(string)}}
+}
+
+func TestFixDiscardsBreakingFixes(t *testing.T) {
+	// changelogs parse unless they contain "broken"
+	parse := func(source []byte) (*model.Changelog, error) {
+		if strings.Contains(string(source), "broken") {
+			return nil, errors.New("unexpected end of file")
+		}
+		return model.NewChangelog(), nil
+	}
+
+	config := &linting.Config{RuleArgs: map[linting.Rule]linting.RuleArgs{
+		editRule{"a-breaking"}: "broken",
+		editRule{"b-valid"}:    "fixed",
+	}}
+	got, err := linting.Linter{}.Fix([]byte("source"), parse, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != "fixed" {
+		t.Errorf("expected the valid fix to be kept, got %q", got)
+	}
+}
+
+func TestLineEnding(t *testing.T) {
+	testCases := map[string]string{
+		"":                "\n",
+		"# Changelog":     "\n",
+		"# Changelog\n":   "\n",
+		"# Changelog\r\n": "\r\n",
+		"\r\n## 1.0.0\n":  "\r\n",
+	}
+
+	for source, want := range testCases {
+		if got := linting.LineEnding([]byte(source)); got != want {
+			t.Errorf("%q: expected %q, got %q", source, want, got)
+		}
+	}
+}
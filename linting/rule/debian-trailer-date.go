@@ -0,0 +1,71 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+type DebianTrailerDate struct{}
+
+// debianDateLayouts are the accepted layouts of the dates of Debian release trailers (RFC 2822)
+var debianDateLayouts = []string{"Mon, 02 Jan 2006 15:04:05 -0700", "Mon, 2 Jan 2006 15:04:05 -0700"}
+
+func (r DebianTrailerDate) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if changes.Format != (parser.Debian{}).Name() {
+		return
+	}
+
+	for _, version := range changes.Versions {
+		if version.Date == "" {
+			continue // releases without trailer are syntax errors
+		}
+
+		msg := r.check(version.Date)
+		if msg == "" {
+			continue
+		}
+
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  fmt.Sprintf("bad date %q in the trailer of version %s: %s", version.Date, version.Version, msg),
+			Position: version.DateRange.Start.Line,
+			Range:    version.DateRange,
+			Node:     version.Version,
+		}
+	}
+}
+
+// check yields what is wrong with the date, or an empty string if the date is valid
+func (DebianTrailerDate) check(date string) string {
+	for _, layout := range debianDateLayouts {
+		t, err := time.Parse(layout, date)
+		if err != nil {
+			continue
+		}
+
+		if weekday := t.Weekday().String()[:3]; !strings.HasPrefix(date, weekday+",") {
+			return fmt.Sprintf("the day of the week should be %s", weekday)
+		}
+
+		return ""
+	}
+
+	return "expecting a RFC 2822 date like Mon, 02 Jan 2006 15:04:05 -0700"
+}
+
+func (DebianTrailerDate) Name() string {
+	return "debian-trailer-date"
+}
+
+func (DebianTrailerDate) Description() string {
+	return "Dates in the trailers of Debian changelog releases must follow RFC 2822."
+}
+
+func (DebianTrailerDate) Help() string {
+	return "Write the date like Mon, 02 Jan 2006 15:04:05 -0700, the output of date -R, and check the day of the week."
+}
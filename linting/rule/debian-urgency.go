@@ -0,0 +1,63 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+type DebianUrgency struct{}
+
+// debianUrgencies are the valid urgencies of Debian releases
+var debianUrgencies = map[string]bool{"low": true, "medium": true, "high": true, "emergency": true, "critical": true}
+
+func (r DebianUrgency) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if changes.Format != (parser.Debian{}).Name() {
+		return
+	}
+
+	for _, version := range changes.Versions {
+		urgency, ok := version.Metadata["urgency"]
+		if !ok {
+			msg := fmt.Sprintf("version %s has no urgency", version.Version)
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: version.Position,
+				Range:    version.Range,
+				Node:     version.Version,
+			}
+			continue
+		}
+
+		// the urgency can be followed by a comment, e.g. low (HIGH for users of the daemon)
+		value := strings.ToLower(strings.Fields(urgency.Value + " ")[0])
+		if debianUrgencies[value] {
+			continue
+		}
+
+		msg := fmt.Sprintf("unknown urgency %q in version %s", urgency.Value, version.Version)
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  msg,
+			Position: urgency.Range.Start.Line,
+			Range:    urgency.Range,
+			Node:     version.Version,
+		}
+	}
+}
+
+func (DebianUrgency) Name() string {
+	return "debian-urgency"
+}
+
+func (DebianUrgency) Description() string {
+	return "Releases of Debian changelogs must have a valid urgency."
+}
+
+func (DebianUrgency) Help() string {
+	return "Set the urgency option of the release heading to low, medium, high, emergency or critical, e.g. urgency=medium."
+}
@@ -0,0 +1,121 @@
+package rule
+
+import (
+	"strconv"
+	"strings"
+)
+
+// compareDebianVersions compares Debian versions ([epoch:]upstream[-revision]) as dpkg does
+func compareDebianVersions(v1, v2 string) int {
+	epoch1, upstream1, revision1 := splitDebianVersion(v1)
+	epoch2, upstream2, revision2 := splitDebianVersion(v2)
+
+	switch {
+	case epoch1 > epoch2:
+		return 1
+	case epoch1 < epoch2:
+		return -1
+	}
+
+	if result := compareDebianParts(upstream1, upstream2); result != 0 {
+		return result
+	}
+
+	return compareDebianParts(revision1, revision2)
+}
+
+// splitDebianVersion yields the epoch, the upstream version and the revision of a Debian version
+func splitDebianVersion(v string) (int, string, string) {
+	epoch := 0
+	if i := strings.Index(v, ":"); i >= 0 {
+		if value, err := strconv.Atoi(v[:i]); err == nil {
+			epoch = value
+			v = v[i+1:]
+		}
+	}
+
+	revision := ""
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		v, revision = v[:i], v[i+1:]
+	}
+
+	return epoch, v, revision
+}
+
+// compareDebianParts compares upstream versions or revisions: alternatively non-digit parts are compared
+// lexically (letters sort before non-letters and ~ sorts before anything, even the end of the part)
+// and digit parts are compared numerically
+func compareDebianParts(p1, p2 string) int {
+	i, j := 0, 0
+	for i < len(p1) || j < len(p2) {
+		for (i < len(p1) && !isDigit(p1[i])) || (j < len(p2) && !isDigit(p2[j])) {
+			o1, o2 := debianOrder(p1, i), debianOrder(p2, j)
+			if o1 != o2 {
+				return sign(o1 - o2)
+			}
+			i++
+			j++
+		}
+
+		for i < len(p1) && p1[i] == '0' {
+			i++
+		}
+		for j < len(p2) && p2[j] == '0' {
+			j++
+		}
+
+		firstDiff := 0
+		for i < len(p1) && isDigit(p1[i]) && j < len(p2) && isDigit(p2[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(p1[i]) - int(p2[j])
+			}
+			i++
+			j++
+		}
+		if i < len(p1) && isDigit(p1[i]) {
+			return 1
+		}
+		if j < len(p2) && isDigit(p2[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return sign(firstDiff)
+		}
+	}
+
+	return 0
+}
+
+// debianOrder yields the weight of the character at the given index of the part in the comparison of non-digit parts
+func debianOrder(part string, i int) int {
+	if i >= len(part) {
+		return 0
+	}
+
+	c := part[i]
+	switch {
+	case isDigit(c):
+		return 0
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func sign(i int) int {
+	switch {
+	case i > 0:
+		return 1
+	case i < 0:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,30 @@
+package rule
+
+import (
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// replaceBlocks yields the edit replacing the given consecutive blocks of the source by new ones.
+// The source between blocks is kept untouched. If there are less new blocks than blocks,
+// the source between the last blocks is dropped.
+func replaceBlocks(source []byte, blocks []model.Range, newBlocks []string) linting.Edit {
+	newText := ""
+	for i, block := range newBlocks {
+		newText += block
+		if i < len(newBlocks)-1 {
+			newText += string(source[blocks[i].End.Offset:blocks[i+1].Start.Offset])
+		}
+	}
+
+	return linting.Edit{
+		Start:   blocks[0].Start.Offset,
+		End:     blocks[len(blocks)-1].End.Offset,
+		NewText: newText,
+	}
+}
+
+// sourceOf yields the source text of the given range
+func sourceOf(source []byte, r model.Range) string {
+	return string(source[r.Start.Offset:r.End.Offset])
+}
@@ -0,0 +1,71 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+type LinkCompare struct{}
+
+func (r LinkCompare) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	links := linkLabels(changes)
+	for i, version := range changes.Versions {
+		link, ok := links[strings.ToLower(version.Version)]
+		if !ok {
+			continue
+		}
+		_, from, to, ok := link.Compare()
+		if !ok {
+			continue // links to tags or releases, like the one of the first version
+		}
+
+		failure := func(msg string) {
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  fmt.Sprintf("compare link of version %s %s", version.Version, msg),
+				Position: link.Position,
+				Range:    link.URLRange,
+				Node:     version.Version,
+			}
+		}
+
+		switch {
+		case version.Version == "Unreleased" && to != "HEAD":
+			failure(fmt.Sprintf("should end at HEAD, got %s", to))
+		case version.Version != "Unreleased" && !isTagOf(to, version.Version):
+			failure(fmt.Sprintf("should end at version %s, got %s", version.Version, to))
+		}
+
+		if i+1 < len(changes.Versions) {
+			older := changes.Versions[i+1].Version
+			if !isTagOf(from, older) {
+				failure(fmt.Sprintf("should start from version %s, got %s", older, from))
+			}
+		}
+	}
+}
+
+// isTagOf returns true if the tag is the tag of the version: the version with an optional prefix, like v1.0.0 for 1.0.0
+func isTagOf(tag, version string) bool {
+	if !strings.HasSuffix(tag, version) {
+		return false
+	}
+
+	prefix := strings.TrimSuffix(tag, version)
+	return prefix == "" || !strings.ContainsAny(prefix[len(prefix)-1:], "0123456789.")
+}
+
+func (LinkCompare) Name() string {
+	return "link-compare"
+}
+
+func (LinkCompare) Description() string {
+	return "Compare links of versions must chain: each version compares from the next older version, Unreleased compares from the latest version to HEAD."
+}
+
+func (LinkCompare) Help() string {
+	return "Fix the references of the compare URL, like [1.1.0]: https://github.com/owner/repo/compare/v1.0.0...v1.1.0 and [Unreleased]: https://github.com/owner/repo/compare/v1.1.0...HEAD."
+}
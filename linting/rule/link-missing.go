@@ -0,0 +1,74 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+type LinkMissing struct{}
+
+func (r LinkMissing) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if changes.Format != (parser.Default{}).Name() {
+		return
+	}
+
+	links := linkLabels(changes)
+	for _, version := range changes.Versions {
+		if !bracketed(*version) {
+			continue
+		}
+		if _, ok := links[strings.ToLower(version.Version)]; ok {
+			continue
+		}
+
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  fmt.Sprintf("version %s has no link reference definition", version.Version),
+			Position: version.Position,
+			Range:    version.NameRange,
+			Node:     version.Version,
+		}
+	}
+}
+
+// linkLabels yields the link reference definitions of the changelog by label, labels are case-insensitive
+func linkLabels(changes model.Changelog) map[string]*model.Link {
+	result := make(map[string]*model.Link, len(changes.Links))
+	for _, link := range changes.Links {
+		label := strings.ToLower(link.Label)
+		if _, ok := result[label]; !ok { // the first definition of a label wins
+			result[label] = link
+		}
+	}
+
+	return result
+}
+
+// bracketed returns true if the name of the version is written as a shortcut reference link in its heading, like ## [1.0.0]
+func bracketed(version model.Version) bool {
+	line := version.SourceLine
+	start := version.NameRange.Start.Offset - version.Range.Start.Offset
+	end := version.NameRange.End.Offset - version.Range.Start.Offset
+	if start < 1 || end >= len(line) || start > end {
+		return false
+	}
+
+	followedByLink := end+1 < len(line) && (line[end+1] == '(' || line[end+1] == '[') // inline or full reference links
+	return line[start-1] == '[' && line[end] == ']' && !followedByLink
+}
+
+func (LinkMissing) Name() string {
+	return "link-missing"
+}
+
+func (LinkMissing) Description() string {
+	return "Versions with a bracketed name in their heading, like ## [1.0.0], must have a link reference definition."
+}
+
+func (LinkMissing) Help() string {
+	return "Add a definition like [1.0.0]: https://github.com/owner/repo/compare/v0.9.0...v1.0.0 at the end of the changelog, or remove the brackets."
+}
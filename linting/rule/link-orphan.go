@@ -0,0 +1,60 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+type LinkOrphan struct{}
+
+func (r LinkOrphan) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if len(changes.Links) == 0 {
+		return
+	}
+
+	text := strings.ToLower(strings.Join(changelogText(changes), "\n"))
+	for _, link := range changes.Links {
+		if strings.Contains(text, "["+strings.ToLower(link.Label)+"]") {
+			continue
+		}
+
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  fmt.Sprintf("link reference definition [%s] is not used", link.Label),
+			Position: link.Position,
+			Range:    link.Range,
+			Node:     link.Label,
+		}
+	}
+}
+
+// changelogText yields the source lines of the changelog, but link reference definitions and directives
+func changelogText(changes model.Changelog) []string {
+	result := append([]string{}, changes.Header...)
+	for _, version := range changes.Versions {
+		result = append(result, version.SourceLine)
+		for _, subsection := range version.Subsections {
+			result = append(result, subsection.SourceLine)
+			for _, entry := range subsection.History {
+				result = append(result, entry.Lines...)
+			}
+		}
+	}
+
+	return result
+}
+
+func (LinkOrphan) Name() string {
+	return "link-orphan"
+}
+
+func (LinkOrphan) Description() string {
+	return "Link reference definitions must be used in the changelog."
+}
+
+func (LinkOrphan) Help() string {
+	return "Remove the definition, or fix its label to match the version it links to."
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/versioning"
 )
 
 type Release struct{}
@@ -25,15 +26,27 @@ func (r Release) Apply(changes model.Changelog, failures chan linting.Failure, a
 
 	headVersion := changes.Versions[0]
 	gotVersion := headVersion.Version
-	if gotVersion != wantVersion {
+	if !versioning.Same(identityScheme(changes), gotVersion, wantVersion) {
 		msg := fmt.Sprintf("expected release version to be %s, got %s instead", wantVersion, gotVersion)
-		failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: headVersion.Position}
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  msg,
+			Position: headVersion.Position,
+			Range:    headVersion.NameRange,
+			Node:     headVersion.Version,
+		}
 	}
 
 	for _, version := range changes.Versions {
 		if version.Version == "Unreleased" {
 			msg := "version Unreleased forbidden in release mode"
-			failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: version.Position}
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: version.Position,
+				Range:    version.NameRange,
+				Node:     version.Version,
+			}
 			break
 		}
 	}
@@ -42,3 +55,11 @@ func (r Release) Apply(changes model.Changelog, failures chan linting.Failure, a
 func (Release) Name() string {
 	return "release"
 }
+
+func (Release) Description() string {
+	return "In release mode, the top version must be the released one and Unreleased is forbidden."
+}
+
+func (Release) Help() string {
+	return "Rename the Unreleased version with the released one."
+}
@@ -1,11 +1,14 @@
 package rule
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/chavacava/changelog-lint/linting"
@@ -60,6 +63,9 @@ var bundles []testBundle = []testBundle{
 			"subsection-order.md": {
 				`subsection "Added" is not sorted alphabetically in version 1.9.0`,
 			},
+			"outline/subsection-order.adoc": {
+				`subsection "Added" is not sorted alphabetically in version 1.1.0`,
+			},
 			"ok.md": { /* no error expected */ },
 		},
 	},
@@ -80,6 +86,15 @@ var bundles []testBundle = []testBundle{
 			"version-repetition.md": {
 				`duplicated version 1.8.0`,
 			},
+			"version-semver.md": {
+				`duplicated version v1.0.0`,
+			},
+			"versioning/calver.md": {
+				`duplicated version 2026.09.0`,
+			},
+			"versioning/pep440.md": {
+				`duplicated version 1.0.0`,
+			},
 			"ok.md": { /* no error expected */ },
 		},
 	},
@@ -91,6 +106,155 @@ var bundles []testBundle = []testBundle{
 				`version Unreleased must be at the top of the version list`,
 				`version 1.10.0 is not well sorted`,
 			},
+			"version-semver.md": {
+				`version 2.0.0-rc.10 is not well sorted`,
+				`malformed version: "1.0.0-01" is not a semantic version`,
+			},
+			"versioning/calver.md": {
+				`version 2026.10.0 is not well sorted`,
+				`malformed version: "2026.9.0" does not follow the calendar versioning format YYYY.0M.MICRO-MODIFIER`,
+			},
+			"versioning/pep440.md": {
+				`version 1.0b2 is not well sorted`,
+				`malformed version: "0.9-final" is not a PEP 440 version`,
+			},
+			"versioning/lexical.md": {
+				`version impish is not well sorted`,
+			},
+			"ok.md": { /* no error expected */ },
+		},
+	},
+	{
+		VersionOrder{},
+		nil,
+		map[string][]string{
+			"debian/version-order": {
+				`version 0.3.0-1 is not well sorted`,
+			},
+			"debian/ok": { /* no error expected */ },
+			"gnu/ChangeLog": {
+				`version 2022-11-20 Jane Doe is not well sorted`,
+			},
+			"gnu/NEWS": {
+				`version 2.10 is not well sorted`,
+			},
+			"outline/version-order.rst": {
+				`version 1.1.0 is not well sorted`,
+			},
+		},
+	},
+	{
+		VersionRepetition{},
+		nil,
+		map[string][]string{
+			"gnu/ChangeLog": { /* entries of the same date and author are not duplicated versions */ },
+			"gnu/NEWS":      { /* no error expected */ },
+		},
+	},
+	{
+		DebianUrgency{},
+		nil,
+		map[string][]string{
+			"debian/urgency": {
+				`unknown urgency "urgent" in version 0.4.0-1`,
+				`version 0.3.0-1 has no urgency`,
+			},
+			"debian/ok": { /* no error expected */ },
+			"ok.md":     { /* no error expected */ },
+		},
+	},
+	{
+		DebianTrailerDate{},
+		nil,
+		map[string][]string{
+			"debian/trailer-date": {
+				`bad date "2022-11-19 10:00:00" in the trailer of version 0.4.0-1: expecting a RFC 2822 date like Mon, 02 Jan 2006 15:04:05 -0700`,
+				`bad date "Mon, 4 Nov 2022 18:30:00 +0100" in the trailer of version 0.3.0-1: the day of the week should be Fri`,
+			},
+			"debian/ok": { /* no error expected */ },
+		},
+	},
+	{
+		LinkMissing{},
+		nil,
+		map[string][]string{
+			"link.md": {
+				`version 1.2.0 has no link reference definition`,
+			},
+			"ok.md":     { /* no error expected */ },
+			"debian/ok": { /* no error expected */ },
+		},
+	},
+	{
+		LinkOrphan{},
+		nil,
+		map[string][]string{
+			"link.md": {
+				`link reference definition [1.0.0] is not used`,
+				`link reference definition [0.9.0] is not used`,
+			},
+			"ok.md": { /* no error expected */ },
+		},
+	},
+	{
+		LinkCompare{},
+		nil,
+		map[string][]string{
+			"link.md": {
+				`compare link of version Unreleased should end at HEAD, got main`,
+				`compare link of version 1.3.0 should start from version 1.2.0, got v1.1.0`,
+			},
+			"ok.md": { /* no error expected */ },
+		},
+	},
+	{
+		VersionDateFormat{},
+		nil,
+		map[string][]string{
+			"version-date.md": {
+				`bad date "2022/11/04" of version 1.3.0, expecting a date like 2006-01-02`,
+			},
+			"debian/trailer-date": { /* no error expected */ },
+			"ok.md":               { /* no error expected */ },
+		},
+	},
+	{
+		VersionDateMissing{},
+		nil,
+		map[string][]string{
+			"version-date.md": {
+				`version 1.2.0 has no release date`,
+			},
+			"outline/version-date.rst": { /* no error expected */ },
+			"ok.md":                    { /* no error expected */ },
+		},
+	},
+	{
+		VersionDateOrder{},
+		nil,
+		map[string][]string{
+			"version-date.md": {
+				`version 1.0.0 is dated 2022-10-15, after version 1.1.0 above it (2022-10-01)`,
+			},
+			"outline/version-date.rst": {
+				`version 1.0.0 is dated 2022-11-19, after version 1.1.0 above it (2022-11-04)`,
+			},
+			"debian/version-order": {
+				`version 0.3.0-1 is dated Fri, 4 Nov 2022 18:30:00 +0100, after version 0.3.0~rc1-1 above it (Tue, 01 Nov 2022 18:30:00 +0100)`,
+			},
+			"gnu/NEWS": {
+				`version 2.10 is dated 2022-03-20, after version 2.9 above it (2022-01-01)`,
+			},
+			"ok.md": { /* no error expected */ },
+		},
+	},
+	{
+		VersionDateFuture{},
+		nil,
+		map[string][]string{
+			"version-date.md": {
+				`version 1.4.0 is dated 2999-01-01, in the future`,
+			},
 			"ok.md": { /* no error expected */ },
 		},
 	},
@@ -113,6 +277,13 @@ var bundles []testBundle = []testBundle{
 			},
 		},
 	},
+	{
+		Release{},
+		"v2.0.0",
+		map[string][]string{
+			"release.md": { /* no error expected */ },
+		},
+	},
 }
 
 func TestRules(t *testing.T) {
@@ -136,15 +307,36 @@ func parseChangelog(filename string) (*model.Changelog, error) {
 		return nil, err
 	}
 
-	p := parser.Default{}
-	changes, err := p.Parse(input, parserConf())
+	return parse(filename, input)
+}
+
+// parse parses the source of a test file, files of the versioning directory are changelogs
+// of the versioning scheme they are named after, parsed with the default version pattern of schemes other than semver
+func parse(filename string, source io.Reader) (*model.Changelog, error) {
+	conf := parserConf()
+	versioned := filepath.Dir(filename) == "versioning"
+	if versioned {
+		conf.VersionPattern = regexp.MustCompile(`^## \[?(Unreleased|[^\s\[\]]+)\]?(?: +- +(?P<date>\S+))?( .*)*$`)
+	}
+
+	changes, err := parserOf(filename).Parse(source, conf)
 	if err != nil {
 		return nil, err
 	}
+	if versioned {
+		changes.Versioning = testSchemes[filepath.Base(filename)]
+	}
 
 	return changes, nil
 }
 
+// testSchemes are the versioning schemes of the files of the versioning directory
+var testSchemes = map[string]string{
+	"calver.md":  "calver:YYYY.0M.MICRO-MODIFIER",
+	"pep440.md":  "pep440",
+	"lexical.md": "lexical",
+}
+
 func ruleTester(r linting.Rule, args any, changes model.Changelog, wantFailureMessages []string) error {
 	failures := make(chan linting.Failure)
 
@@ -176,11 +368,153 @@ func ruleTester(r linting.Rule, args any, changes model.Changelog, wantFailureMe
 	return nil
 }
 
+// parserOf yields the parser of a test file, files of the debian and gnu directories are Debian and GNU changelogs,
+// .rst and .adoc files are reStructuredText and AsciiDoc changelogs
+func parserOf(filename string) parser.Parser {
+	switch {
+	case filepath.Ext(filename) == ".rst":
+		return parser.RST{}
+	case filepath.Ext(filename) == ".adoc":
+		return parser.AsciiDoc{}
+	case filepath.Dir(filename) == "debian":
+		return parser.Debian{}
+	case filename == "gnu/ChangeLog":
+		return parser.GNUChangeLog{}
+	case filename == "gnu/NEWS":
+		return parser.GNUNews{}
+	default:
+		return parser.Default{}
+	}
+}
+
 func parserConf() *parser.Config {
 	return &parser.Config{
 		TitlePattern:      regexp.MustCompile(`.+`),
-		VersionPattern:    regexp.MustCompile(`^## \[?(\d+\.\d+.\d+|Unreleased)\]?( .*)*$`),
+		VersionPattern:    regexp.MustCompile(`^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$`),
 		SubsectionPattern: regexp.MustCompile(`^### ([A-Z]+[a-z]+)[ ]*$`),
 		EntryPattern:      regexp.MustCompile(`^[*-] .+$`),
 	}
 }
+
+func TestRulesDocumentation(t *testing.T) {
+	for _, bundle := range bundles {
+		doc, ok := bundle.rule.(linting.Documented)
+		if !ok {
+			t.Fatalf("rule %s is not documented", bundle.rule.Name())
+		}
+		if doc.Description() == "" || doc.Help() == "" {
+			t.Fatalf("rule %s has an empty description or help", bundle.rule.Name())
+		}
+	}
+}
+
+func TestFixes(t *testing.T) {
+	testCases := []struct {
+		rule      linting.Rule
+		file      string
+		remaining []string // failures left after fixing
+	}{
+		{SubsectionEmpty{}, "subsection-empty.md", nil},
+		{SubsectionOrder{}, "subsection-order.md", nil},
+		{SubsectionRepetition{}, "subsection-repetition.md", nil},
+		{SubsectionRepetition{}, "subsection-repetition-crlf.md", nil},
+		{VersionOrder{}, "version-order.md", nil},
+		{VersionOrder{}, "ok.md", nil},
+		{VersionOrder{}, "debian/version-order", nil},
+		{VersionOrder{}, "gnu/NEWS", nil},
+		{VersionOrder{}, "outline/version-order.rst", nil},
+		{SubsectionOrder{}, "outline/subsection-order.adoc", nil},
+		{VersionOrder{}, "versioning/lexical.md", nil},
+		// moving the empty version at the end of the file would break the changelog
+		{VersionOrder{}, "version-order-empty.md", []string{"version 1.0.0 is not well sorted"}},
+	}
+
+	for _, tc := range testCases {
+		parse := func(source []byte) (*model.Changelog, error) {
+			return parse(tc.file, bytes.NewReader(source))
+		}
+
+		source, err := os.ReadFile(filepath.Join("testdata", tc.file))
+		if err != nil {
+			t.Fatalf("%s/%s: %v", tc.rule.Name(), tc.file, err)
+		}
+
+		config := &linting.Config{RuleArgs: map[linting.Rule]linting.RuleArgs{tc.rule: nil}}
+		got, err := linting.Linter{}.Fix(source, parse, config)
+		if err != nil {
+			t.Fatalf("%s/%s: unexpected error: %v", tc.rule.Name(), tc.file, err)
+		}
+
+		want := source
+		goldenPath := filepath.Join("testdata", "fixed", tc.file)
+		if _, err := os.Stat(goldenPath); err == nil {
+			want, _ = os.ReadFile(goldenPath)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("%s/%s: expected fixed changelog:\n%s\ngot:\n%s", tc.rule.Name(), tc.file, want, got)
+		}
+
+		changes, err := parse(got)
+		if err != nil {
+			t.Fatalf("%s/%s: unexpected error parsing the fixed changelog: %v", tc.rule.Name(), tc.file, err)
+		}
+		if err := ruleTester(tc.rule, nil, *changes, tc.remaining); err != nil {
+			t.Fatalf("%s/%s: fixed changelog still fails: %v", tc.rule.Name(), tc.file, err)
+		}
+	}
+}
+
+func TestCompareDebianVersions(t *testing.T) {
+	testCases := []struct {
+		v1, v2 string
+		want   int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0-1", "1.0-2", -1},
+		{"1.0", "1.0-0", 0},
+		{"1.10", "1.9", 1},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0~~", "1.0~", -1},
+		{"1.0a", "1.0", 1},
+		{"1.0a", "1.0+", -1},
+		{"1.0.1", "1.0a", 1},
+		{"1:0.1", "2.0", 1},
+		{"2.0-1ubuntu1", "2.0-1", 1},
+		{"2.0+dfsg-1", "2.0-1", 1},
+		{"001.0", "1.0", 0},
+	}
+
+	for _, tc := range testCases {
+		if got := compareDebianVersions(tc.v1, tc.v2); got != tc.want {
+			t.Errorf("compareDebianVersions(%q, %q): expected %d, got %d", tc.v1, tc.v2, tc.want, got)
+		}
+		if got := compareDebianVersions(tc.v2, tc.v1); got != -tc.want {
+			t.Errorf("compareDebianVersions(%q, %q): expected %d, got %d", tc.v2, tc.v1, -tc.want, got)
+		}
+	}
+}
+
+func TestSubsectionNamingAllowedNames(t *testing.T) {
+	testCases := []struct {
+		args linting.RuleArgs
+		want string
+	}{
+		{nil, "Added Changed Deprecated Fixed Removed Security"},
+		{[]any{"Fixed", "Added"}, "Added Fixed"},
+	}
+
+	for _, tc := range testCases {
+		got, err := SubsectionNaming{}.AllowedNames(tc.args)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Join(got, " ") != tc.want {
+			t.Errorf("expected %q for %v, got %q", tc.want, tc.args, got)
+		}
+	}
+
+	if _, err := (SubsectionNaming{}).AllowedNames([]any{42}); err == nil {
+		t.Errorf("expected an error for a non string name")
+	}
+}
@@ -14,7 +14,13 @@ func (r SubsectionEmpty) Apply(changes model.Changelog, failures chan linting.Fa
 		for _, subsection := range version.Subsections {
 			if len(subsection.History) == 0 {
 				msg := fmt.Sprintf("empty subsection %q in version %v", subsection.Name, version.Version)
-				failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: subsection.Position}
+				failures <- linting.Failure{
+					RuleName: r.Name(),
+					Message:  msg,
+					Position: subsection.Position,
+					Range:    subsection.Range,
+					Node:     linting.NodeID(version.Version, subsection.Name),
+				}
 			}
 		}
 	}
@@ -23,3 +29,29 @@ func (r SubsectionEmpty) Apply(changes model.Changelog, failures chan linting.Fa
 func (SubsectionEmpty) Name() string {
 	return "subsection-empty"
 }
+
+func (SubsectionEmpty) Description() string {
+	return "Subsections must contain at least one entry."
+}
+
+func (SubsectionEmpty) Help() string {
+	return "Add entries to the subsection or remove it."
+}
+
+// Fix removes empty subsections
+func (SubsectionEmpty) Fix(changes model.Changelog, _ []byte, _ linting.RuleArgs) []linting.Edit {
+	edits := []linting.Edit{}
+	for _, version := range changes.Versions {
+		previousEnd := version.Range.End
+		for _, subsection := range version.Subsections {
+			end := subsection.Extent().End
+			if len(subsection.History) == 0 {
+				// remove the subsection and the source separating it from the previous node
+				edits = append(edits, linting.Edit{Start: previousEnd.Offset, End: end.Offset})
+			}
+			previousEnd = end
+		}
+	}
+
+	return edits
+}
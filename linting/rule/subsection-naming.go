@@ -2,6 +2,7 @@ package rule
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/model"
@@ -17,14 +18,24 @@ func (r SubsectionNaming) Apply(changes model.Changelog, failures chan linting.F
 		return
 	}
 
+	if changes.AuthorSubsections {
+		return // subsections are not kinds of changes
+	}
+
 	for _, version := range changes.Versions {
 		for _, subsection := range version.Subsections {
 			_, ok := allowedSubsections[subsection.Name]
-			if ok {
+			if ok || subsection.Name == "" { // unnamed subsections gather entries of formats without subsections
 				continue
 			}
 			msg := fmt.Sprintf("unknown subsection %q in version %v", subsection.Name, version.Version)
-			failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: subsection.Position}
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: subsection.Position,
+				Range:    subsection.NameRange,
+				Node:     linting.NodeID(version.Version, subsection.Name),
+			}
 		}
 	}
 }
@@ -33,6 +44,30 @@ func (SubsectionNaming) Name() string {
 	return "subsection-naming"
 }
 
+func (SubsectionNaming) Description() string {
+	return "Subsection names must be one of the allowed names."
+}
+
+func (SubsectionNaming) Help() string {
+	return "Rename the subsection with one of the allowed names. By default, allowed names are Added, Changed, Deprecated, Fixed, Removed and Security; the list can be set through the rule arguments."
+}
+
+// AllowedNames yields the sorted names of subsections allowed by the rule configured with the given arguments
+func (r SubsectionNaming) AllowedNames(args linting.RuleArgs) ([]string, error) {
+	allowed, err := r.allowedSubsections(args)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(allowed))
+	for name := range allowed {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
 func (r SubsectionNaming) allowedSubsections(args linting.RuleArgs) (map[string]struct{}, error) {
 	result := map[string]struct{}{
 		"Added":      {},
@@ -2,6 +2,7 @@ package rule
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/model"
@@ -10,12 +11,22 @@ import (
 type SubsectionOrder struct{}
 
 func (r SubsectionOrder) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if changes.AuthorSubsections {
+		return // subsections are not kinds of changes
+	}
+
 	for _, version := range changes.Versions {
 		previousName := ""
 		for _, subsection := range version.Subsections {
 			if previousName != "" && subsection.Name < previousName {
 				msg := fmt.Sprintf("subsection %q is not sorted alphabetically in version %v", subsection.Name, version.Version)
-				failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: subsection.Position}
+				failures <- linting.Failure{
+					RuleName: r.Name(),
+					Message:  msg,
+					Position: subsection.Position,
+					Range:    subsection.NameRange,
+					Node:     linting.NodeID(version.Version, subsection.Name),
+				}
 			}
 			previousName = subsection.Name
 		}
@@ -25,3 +36,40 @@ func (r SubsectionOrder) Apply(changes model.Changelog, failures chan linting.Fa
 func (SubsectionOrder) Name() string {
 	return "subsection-order"
 }
+
+func (SubsectionOrder) Description() string {
+	return "Subsections of a version must be sorted alphabetically."
+}
+
+func (SubsectionOrder) Help() string {
+	return "Reorder the subsections of the version alphabetically."
+}
+
+// Fix sorts the subsections of the versions alphabetically
+func (SubsectionOrder) Fix(changes model.Changelog, source []byte, _ linting.RuleArgs) []linting.Edit {
+	if changes.AuthorSubsections {
+		return nil
+	}
+
+	edits := []linting.Edit{}
+	for _, version := range changes.Versions {
+		subsections := version.Subsections
+		isSorted := sort.SliceIsSorted(subsections, func(i, j int) bool { return subsections[i].Name < subsections[j].Name })
+		if isSorted {
+			continue
+		}
+
+		sorted := append([]*model.Subsection{}, subsections...)
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+		blocks := make([]model.Range, len(subsections))
+		newBlocks := make([]string, len(sorted))
+		for i := range subsections {
+			blocks[i] = subsections[i].Extent()
+			newBlocks[i] = sourceOf(source, sorted[i].Extent())
+		}
+		edits = append(edits, replaceBlocks(source, blocks, newBlocks))
+	}
+
+	return edits
+}
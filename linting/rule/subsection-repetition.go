@@ -17,7 +17,13 @@ func (r SubsectionRepetition) Apply(changes model.Changelog, failures chan linti
 			_, alreadySeen := seen[name]
 			if alreadySeen {
 				msg := fmt.Sprintf("duplicated subsection %q", name)
-				failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: subsection.Position}
+				failures <- linting.Failure{
+					RuleName: r.Name(),
+					Message:  msg,
+					Position: subsection.Position,
+					Range:    subsection.NameRange,
+					Node:     linting.NodeID(version.Version, subsection.Name),
+				}
 			}
 			seen[name] = struct{}{}
 		}
@@ -27,3 +33,44 @@ func (r SubsectionRepetition) Apply(changes model.Changelog, failures chan linti
 func (SubsectionRepetition) Name() string {
 	return "subsection-repetition"
 }
+
+func (SubsectionRepetition) Description() string {
+	return "A subsection must appear at most once in a version."
+}
+
+func (SubsectionRepetition) Help() string {
+	return "Move the entries of the duplicated subsection into the first one and remove the duplicate."
+}
+
+// Fix merges the entries of duplicated subsections into their first occurrence
+func (SubsectionRepetition) Fix(changes model.Changelog, source []byte, _ linting.RuleArgs) []linting.Edit {
+	edits := []linting.Edit{}
+	newline := linting.LineEnding(source)
+	for _, version := range changes.Versions {
+		blocks := []model.Range{}
+		newBlocks := []string{}
+		blockIndex := map[string]int{}
+		for _, subsection := range version.Subsections {
+			extent := subsection.Extent()
+			blocks = append(blocks, extent)
+			i, alreadySeen := blockIndex[subsection.Name]
+			if !alreadySeen {
+				blockIndex[subsection.Name] = len(newBlocks)
+				newBlocks = append(newBlocks, sourceOf(source, extent))
+				continue
+			}
+
+			if len(subsection.History) > 0 {
+				entries := model.Range{Start: subsection.History[0].Range.Start, End: extent.End}
+				newBlocks[i] += newline + sourceOf(source, entries)
+			}
+		}
+
+		if len(newBlocks) == len(blocks) {
+			continue // no duplicated subsection
+		}
+		edits = append(edits, replaceBlocks(source, blocks, newBlocks))
+	}
+
+	return edits
+}
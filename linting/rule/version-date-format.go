@@ -0,0 +1,66 @@
+package rule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+type VersionDateFormat struct{}
+
+func (r VersionDateFormat) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if changes.DateLayout == "" || changes.Format == (parser.Debian{}).Name() {
+		return // dates of Debian releases are checked by the debian-trailer-date rule
+	}
+
+	for _, version := range changes.Versions {
+		if version.Date == "" {
+			continue
+		}
+		if _, ok := versionDate(changes, *version); ok {
+			continue
+		}
+
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  fmt.Sprintf("bad date %q of version %s, expecting a date like %s", version.Date, version.Version, changes.DateLayout),
+			Position: version.DateRange.Start.Line,
+			Range:    version.DateRange,
+			Node:     version.Version,
+		}
+	}
+}
+
+// versionDate yields the release date of the version, false if the version has no date or if the date is malformed
+func versionDate(changes model.Changelog, version model.Version) (time.Time, bool) {
+	if version.Date == "" {
+		return time.Time{}, false
+	}
+
+	layouts := []string{changes.DateLayout}
+	if changes.Format == (parser.Debian{}).Name() {
+		layouts = debianDateLayouts
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, version.Date); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (VersionDateFormat) Name() string {
+	return "version-date-format"
+}
+
+func (VersionDateFormat) Description() string {
+	return "Release dates of versions must follow the date layout of the changelog."
+}
+
+func (VersionDateFormat) Help() string {
+	return "Write the date like 2006-01-02, or in the layout set by the date-layout option of the parser configuration."
+}
@@ -0,0 +1,44 @@
+package rule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+type VersionDateFuture struct{}
+
+func (r VersionDateFuture) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	today := time.Now().Format(dayLayout)
+	for _, version := range changes.Versions {
+		date, ok := versionDate(changes, *version)
+		if !ok || date.Format(dayLayout) <= today {
+			continue
+		}
+
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  fmt.Sprintf("version %s is dated %s, in the future", version.Version, version.Date),
+			Position: version.DateRange.Start.Line,
+			Range:    version.DateRange,
+			Node:     version.Version,
+		}
+	}
+}
+
+// dayLayout is the layout comparing dates by calendar day
+const dayLayout = "2006-01-02"
+
+func (VersionDateFuture) Name() string {
+	return "version-date-future"
+}
+
+func (VersionDateFuture) Description() string {
+	return "Release dates must not be in the future."
+}
+
+func (VersionDateFuture) Help() string {
+	return "Fix the release date, or keep the changes in the Unreleased version until the release."
+}
@@ -0,0 +1,42 @@
+package rule
+
+import (
+	"fmt"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+type VersionDateMissing struct{}
+
+func (r VersionDateMissing) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	if changes.DateLayout == "" {
+		return // the format has no dates
+	}
+
+	for _, version := range changes.Versions {
+		if version.Date != "" || version.Version == "Unreleased" {
+			continue
+		}
+
+		failures <- linting.Failure{
+			RuleName: r.Name(),
+			Message:  fmt.Sprintf("version %s has no release date", version.Version),
+			Position: version.Position,
+			Range:    version.Range,
+			Node:     version.Version,
+		}
+	}
+}
+
+func (VersionDateMissing) Name() string {
+	return "version-date-missing"
+}
+
+func (VersionDateMissing) Description() string {
+	return "Versions, other than Unreleased, must have a release date."
+}
+
+func (VersionDateMissing) Help() string {
+	return "Add the release date to the version heading, like ## [1.0.0] - 2006-01-02."
+}
@@ -0,0 +1,46 @@
+package rule
+
+import (
+	"fmt"
+
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/model"
+)
+
+type VersionDateOrder struct{}
+
+func (r VersionDateOrder) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
+	var previous *model.Version // the closest version above with a valid date
+	for _, version := range changes.Versions {
+		date, ok := versionDate(changes, *version)
+		if !ok {
+			continue // missing and malformed dates are reported by other rules
+		}
+
+		if previous != nil {
+			previousDate, _ := versionDate(changes, *previous)
+			if date.After(previousDate) {
+				failures <- linting.Failure{
+					RuleName: r.Name(),
+					Message:  fmt.Sprintf("version %s is dated %s, after version %s above it (%s)", version.Version, version.Date, previous.Version, previous.Date),
+					Position: version.DateRange.Start.Line,
+					Range:    version.DateRange,
+					Node:     version.Version,
+				}
+			}
+		}
+		previous = version
+	}
+}
+
+func (VersionDateOrder) Name() string {
+	return "version-date-order"
+}
+
+func (VersionDateOrder) Description() string {
+	return "Release dates must not increase from the top to the bottom of the changelog."
+}
+
+func (VersionDateOrder) Help() string {
+	return "Fix the release dates or sort the versions, newest first."
+}
@@ -14,7 +14,13 @@ func (r VersionEmpty) Apply(changes model.Changelog, failures chan linting.Failu
 	for _, version := range changes.Versions {
 		if len(version.Subsections) == 0 && version.Version != "Unreleased" {
 			msg := fmt.Sprintf("empty version %q", version.Version)
-			failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: version.Position}
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: version.Position,
+				Range:    version.Range,
+				Node:     version.Version,
+			}
 		}
 		seen[version.Version] = struct{}{}
 	}
@@ -23,3 +29,11 @@ func (r VersionEmpty) Apply(changes model.Changelog, failures chan linting.Failu
 func (VersionEmpty) Name() string {
 	return "version-empty"
 }
+
+func (VersionEmpty) Description() string {
+	return "Versions, other than Unreleased, must contain at least one subsection."
+}
+
+func (VersionEmpty) Help() string {
+	return "Add the changes of the version or remove it."
+}
@@ -2,31 +2,64 @@ package rule
 
 import (
 	"fmt"
-	"strconv"
+	"sort"
 	"strings"
 
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/versioning"
 )
 
 type VersionOrder struct{}
 
 func (r VersionOrder) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
-	previousVersion := ""
+	compare, check, err := r.comparator(changes)
+	if err != nil {
+		msg := fmt.Sprintf("bad versioning configuration: %v", err)
+		failures <- linting.Failure{RuleName: r.Name(), Message: msg}
+		return
+	}
+
+	var previousVersion *model.Version
 	for _, version := range changes.Versions {
-		if previousVersion != "" && version.Version == "Unreleased" {
+		if version.Version != "Unreleased" && check != nil {
+			if err := check(version); err != nil {
+				failures <- linting.Failure{
+					RuleName: r.Name(),
+					Message:  fmt.Sprintf("malformed version: %v", err),
+					Position: version.Position,
+					Range:    version.NameRange,
+					Node:     version.Version,
+				}
+				continue // malformed versions are not compared
+			}
+		}
+
+		if previousVersion != nil && version.Version == "Unreleased" {
 			msg := "version Unreleased must be at the top of the version list"
-			failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: version.Position}
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: version.Position,
+				Range:    version.NameRange,
+				Node:     version.Version,
+			}
 			continue
 		}
 
-		if previousVersion != "" &&
-			previousVersion != "Unreleased" &&
-			r.compareVersions(previousVersion, version.Version) < 0 {
+		if previousVersion != nil &&
+			previousVersion.Version != "Unreleased" &&
+			compare(previousVersion, version) < 0 {
 			msg := fmt.Sprintf("version %s is not well sorted", version.Version)
-			failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: version.Position}
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: version.Position,
+				Range:    version.NameRange,
+				Node:     version.Version,
+			}
 		}
-		previousVersion = version.Version
+		previousVersion = version
 	}
 }
 
@@ -34,33 +67,94 @@ func (VersionOrder) Name() string {
 	return "version-order"
 }
 
-func (VersionOrder) compareVersions(v1 string, v2 string) int {
-	v1Parts := strings.Split(v1, ".")
-	v2Parts := strings.Split(v2, ".")
-	if len(v1Parts) != 3 || len(v2Parts) != 3 {
-		panic(fmt.Sprintf("%q or %q is not a semver string", v1, v2))
+func (VersionOrder) Description() string {
+	return "Versions must be sorted from the newest to the oldest, with Unreleased at the top."
+}
+
+func (VersionOrder) Help() string {
+	return "Move the version to its place in the version list."
+}
+
+// Fix sorts the versions from the newest to the oldest, with Unreleased at the top
+func (r VersionOrder) Fix(changes model.Changelog, source []byte, _ linting.RuleArgs) []linting.Edit {
+	versions := changes.Versions
+	compare, check, err := r.comparator(changes)
+	if err != nil {
+		return nil
 	}
 
-	for i, p1 := range v1Parts {
-		p2 := v2Parts[i]
-		value1, err := strconv.Atoi(p1)
-		if err != nil {
-			panic(fmt.Sprintf("%q is not a semver string: %v", v1, err))
-		}
-		value2, err := strconv.Atoi(p2)
-		if err != nil {
-			panic(fmt.Sprintf("%q is not a semver string: %v", v2, err))
+	for _, version := range versions {
+		if version.Version != "Unreleased" && check != nil && check(version) != nil {
+			return nil // unable to sort versions that are not comparable
 		}
+	}
 
-		if value1 > value2 {
-			return 1
+	newer := func(v1, v2 *model.Version) bool {
+		switch {
+		case v1.Version == "Unreleased":
+			return v2.Version != "Unreleased"
+		case v2.Version == "Unreleased":
+			return false
+		default:
+			return compare(v1, v2) > 0
 		}
+	}
 
-		if value1 < value2 {
-			return -1
+	isSorted := sort.SliceIsSorted(versions, func(i, j int) bool { return newer(versions[i], versions[j]) })
+	if isSorted {
+		return nil
+	}
+
+	sorted := append([]*model.Version{}, versions...)
+	sort.SliceStable(sorted, func(i, j int) bool { return newer(sorted[i], sorted[j]) })
+
+	blocks := make([]model.Range, len(versions))
+	newBlocks := make([]string, len(sorted))
+	for i := range versions {
+		blocks[i] = versions[i].Extent()
+		newBlocks[i] = sourceOf(source, sorted[i].Extent())
+	}
+
+	return []linting.Edit{replaceBlocks(source, blocks, newBlocks)}
+}
+
+// comparator yields the function comparing versions of the changelog
+// and the function checking that a version can be compared, nil if all versions can be compared.
+// Versions are compared only if they pass the check.
+func (VersionOrder) comparator(changes model.Changelog) (compare func(v1, v2 *model.Version) int, check func(v *model.Version) error, err error) {
+	switch {
+	case changes.VersionOrdering == model.OrderByDpkgVersions:
+		return func(v1, v2 *model.Version) int { return compareDebianVersions(v1.Version, v2.Version) }, nil, nil
+	case changes.VersionOrdering == model.OrderByDates:
+		return func(v1, v2 *model.Version) int {
+			return strings.Compare(v1.Date, v2.Date)
+		}, nil, nil
+	case changes.VersionOrdering == model.OrderByUpstreamVersions:
+		return func(v1, v2 *model.Version) int { return compareDebianParts(v1.Version, v2.Version) }, nil, nil
+	default:
+		scheme, err := versioning.New(changes.Versioning)
+		if err != nil {
+			return nil, nil, err
 		}
+		compare := func(v1, v2 *model.Version) int { return scheme.Compare(v1.Version, v2.Version) }
+		check := func(v *model.Version) error { return scheme.Check(v.Version) }
+		return compare, check, nil
+	}
+}
 
-		continue
+// identityScheme yields the scheme identifying the versions of the changelog (see versioning.Same):
+// its versioning scheme, versions of the same precedence are the same, like v1.0.0 and 1.0.0+build.1 in semver,
+// or the lexical scheme, versions of the same name are the same, for formats with their own version ordering (Debian and GNU)
+// and for unknown versioning schemes (reported by version-order)
+func identityScheme(changes model.Changelog) versioning.Scheme {
+	if changes.VersionOrdering != "" {
+		return versioning.Lexical{}
 	}
-	return 0
+
+	scheme, err := versioning.New(changes.Versioning)
+	if err != nil {
+		return versioning.Lexical{}
+	}
+
+	return scheme
 }
@@ -5,22 +5,48 @@ import (
 
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/versioning"
 )
 
 type VersionRepetition struct{}
 
 func (r VersionRepetition) Apply(changes model.Changelog, failures chan linting.Failure, _ linting.RuleArgs) {
-	seen := map[string]struct{}{}
+	if changes.VersionOrdering == model.OrderByDates {
+		return // versions are dates and authors, several entries can share them
+	}
+
+	scheme := identityScheme(changes)
+	seen := []string{}
 	for _, version := range changes.Versions {
-		_, alreadySeen := seen[version.Version]
+		alreadySeen := false
+		for _, name := range seen {
+			if versioning.Same(scheme, name, version.Version) {
+				alreadySeen = true
+				break
+			}
+		}
 		if alreadySeen {
 			msg := fmt.Sprintf("duplicated version %s", version.Version)
-			failures <- linting.Failure{RuleName: r.Name(), Message: msg, Position: version.Position}
+			failures <- linting.Failure{
+				RuleName: r.Name(),
+				Message:  msg,
+				Position: version.Position,
+				Range:    version.NameRange,
+				Node:     version.Version,
+			}
 		}
-		seen[version.Version] = struct{}{}
+		seen = append(seen, version.Version)
 	}
 }
 
 func (VersionRepetition) Name() string {
 	return "version-repetition"
 }
+
+func (VersionRepetition) Description() string {
+	return "A version must appear at most once in the changelog."
+}
+
+func (VersionRepetition) Help() string {
+	return "Merge the changes of the duplicated version into the first one and remove the duplicate."
+}
@@ -0,0 +1,43 @@
+package linting
+
+import "fmt"
+
+// Severity of failures
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+var severityRanks = map[Severity]int{
+	SeverityInfo:    0,
+	SeverityWarning: 1,
+	SeverityError:   2,
+}
+
+// ParseSeverity yields the severity of the given name
+func ParseSeverity(name string) (Severity, error) {
+	severity := Severity(name)
+	if _, ok := severityRanks[severity]; !ok {
+		return "", fmt.Errorf("unknown severity %q, expected one of %s, %s or %s", name, SeverityError, SeverityWarning, SeverityInfo)
+	}
+
+	return severity, nil
+}
+
+// AtLeast returns true if the severity is greater than or equal to the given one.
+// Unknown severities are considered as errors.
+func (s Severity) AtLeast(other Severity) bool {
+	return s.rank() >= other.rank()
+}
+
+func (s Severity) rank() int {
+	rank, ok := severityRanks[s]
+	if !ok {
+		return severityRanks[SeverityError]
+	}
+
+	return rank
+}
@@ -0,0 +1,127 @@
+package linting
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// unusedSuppressionRule is the rule name of the warnings about suppressions not suppressing any failure
+const unusedSuppressionRule = "unused-suppression"
+
+// suppression disables a rule, or all rules if the rule is empty, from the line first to the line last (included)
+type suppression struct {
+	directive *model.Directive
+	rule      string
+	first     int
+	last      int
+	used      bool
+}
+
+// overlaps returns true if the suppression disables the rule on some line between first and last (included)
+func (s suppression) overlaps(ruleName string, first, last int) bool {
+	return (s.rule == "" || s.rule == ruleName) && first <= s.last && last >= s.first
+}
+
+type suppressions []*suppression
+
+// newSuppressions builds the suppressions of the given directives.
+// A disable directive lasts until an enable directive targeting the same rule or all rules.
+func newSuppressions(directives []*model.Directive) suppressions {
+	sorted := append([]*model.Directive{}, directives...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	result := suppressions{}
+	open := map[string]*suppression{}
+	for _, d := range sorted {
+		rules := d.Rules
+		if len(rules) == 0 {
+			rules = []string{""}
+		}
+
+		switch d.Kind {
+		case model.DirectiveDisableNextLine:
+			for _, r := range rules {
+				result = append(result, &suppression{directive: d, rule: r, first: d.Position + 1, last: d.Position + 1})
+			}
+		case model.DirectiveDisable:
+			for _, r := range rules {
+				if _, ok := open[r]; ok {
+					continue // already disabled
+				}
+				s := &suppression{directive: d, rule: r, first: d.Position + 1, last: math.MaxInt}
+				open[r] = s
+				result = append(result, s)
+			}
+		case model.DirectiveEnable:
+			for r, s := range open {
+				if len(d.Rules) == 0 || contains(d.Rules, r) {
+					s.last = d.Position - 1
+					delete(open, r)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// suppress returns true if the failure is suppressed, suppressions of the failure are marked as used
+func (ss suppressions) suppress(failure Failure) bool {
+	suppressed := false
+	for _, s := range ss {
+		if s.overlaps(failure.RuleName, failure.Position, failure.Position) {
+			s.used = true
+			suppressed = true
+		}
+	}
+
+	return suppressed
+}
+
+// overlap returns true if some suppression disables the rule on some line between first and last (included)
+func (ss suppressions) overlap(ruleName string, first, last int) bool {
+	for _, s := range ss {
+		if s.overlaps(ruleName, first, last) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unused yields warnings for the suppressions that did not suppress any failure
+func (ss suppressions) unused() []Failure {
+	result := []Failure{}
+	for _, s := range ss {
+		if s.used {
+			continue
+		}
+
+		target := "all rules"
+		if s.rule != "" {
+			target = "rule " + s.rule
+		}
+		result = append(result, Failure{
+			RuleName: unusedSuppressionRule,
+			Message:  fmt.Sprintf("changelog-lint-%s directive does not suppress any failure of %s", s.directive.Kind, target),
+			Position: s.directive.Position,
+			Range:    s.directive.Range,
+			Severity: SeverityWarning,
+		})
+	}
+
+	return result
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
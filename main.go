@@ -1,15 +1,21 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"os"
 	"runtime/debug"
+	"sort"
 	"strings"
 
+	"github.com/chavacava/changelog-lint/baseline"
 	"github.com/chavacava/changelog-lint/config"
+	"github.com/chavacava/changelog-lint/diff"
+	"github.com/chavacava/changelog-lint/formatter"
 	"github.com/chavacava/changelog-lint/linting"
 	"github.com/chavacava/changelog-lint/linting/rule"
+	"github.com/chavacava/changelog-lint/model"
 	"github.com/chavacava/changelog-lint/parser"
 )
 
@@ -32,10 +38,34 @@ func main() {
 }
 
 func run(args []string) int {
+	if len(args) > 1 {
+		switch args[1] {
+		case "fmt":
+			return runFormat(args)
+		case "export":
+			return runExport(args)
+		case "import":
+			return runImport(args)
+		case "notes":
+			return runNotes(args)
+		case "release":
+			return runRelease(args)
+		case "add":
+			return runAdd(args)
+		}
+	}
+
 	flags := flag.NewFlagSet(args[0], flag.ExitOnError)
 	flagVersion := flags.Bool("version", false, "get changelog-lint version")
 	flagConfig := flags.String("config", "", "set linter configuration")
 	flagReleaseMode := flags.String("release", "", "enables release-related checks (the given string must be the release version, e.g. 1.2.3)")
+	flagFormatIn := flags.String("format-in", "", "set the changelog format ("+strings.Join(parser.Names(), ", ")+"), overrides the configuration")
+	flagFormat := flags.String("format", "text", "set output format ("+strings.Join(config.FormatterNames(), ", ")+")")
+	flagFailOn := flags.String("fail-on", string(linting.SeverityError), "set the minimum severity of failures making the linter fail (error, warning, info)")
+	flagFix := flags.Bool("fix", false, "fix the changelog in place when possible")
+	flagDiff := flags.Bool("diff", false, "print the fixes as a unified diff instead of applying them, exit with a lint error code if there are fixes")
+	flagBaseline := flags.String("baseline", "", "set the baseline file of failures to ignore")
+	flagWriteBaseline := flags.Bool("write-baseline", false, "record the current failures in the baseline file instead of reporting them")
 
 	if err := flags.Parse(args[1:]); err != nil {
 		fmt.Println(err)
@@ -47,59 +77,249 @@ func run(args []string) int {
 		return codeOK
 	}
 
-	freeArgs := flags.Args()
-	inputFilename := "CHANGELOG.md"
-	if len(freeArgs) > 0 {
-		inputFilename = freeArgs[0]
+	outputFormatter, err := config.GetFormatter(*flagFormat)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
 	}
-	input, err := os.Open(inputFilename)
+
+	failOn, err := linting.ParseSeverity(*flagFailOn)
 	if err != nil {
 		fmt.Println(err)
 		return codeRequestError
 	}
-	defer input.Close()
+
+	if *flagWriteBaseline && *flagBaseline == "" {
+		fmt.Println("-write-baseline requires a baseline file (-baseline)")
+		return codeRequestError
+	}
 
 	mainConfig, err := config.LoadConfig(*flagConfig)
 	if err != nil {
 		fmt.Println(err)
 		return codeRequestError
 	}
+	if *flagFormatIn != "" {
+		mainConfig.Parser.Format = *flagFormatIn
+	}
 
-	parserConf, err := mainConfig.ParserConfig()
+	p, err := mainConfig.ChangelogParser()
 	if err != nil {
 		fmt.Println(err)
 		return codeRequestError
 	}
 
-	p := parser.Default{}
-	changes, err := p.Parse(input, parserConf)
+	inputFilename := changelogFilename(flags.Args())
+	source, err := readChangelog(p, inputFilename)
 	if err != nil {
 		fmt.Println(err)
-		return codeSyntaxError
+		return codeRequestError
+	}
+
+	parse, err := newParseFunc(p, mainConfig, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
 	}
 
-	linter := linting.Linter{}
-	failures := make(chan linting.Failure)
 	lintingConfig := mainConfig.LintingConfig()
 	if *flagReleaseMode != "" {
 		releaseRule := &rule.Release{}
 		lintingConfig.RuleArgs[releaseRule] = *flagReleaseMode
 	}
-	go linter.Lint(*changes, lintingConfig, failures)
+
+	linter := linting.Linter{}
+	if *flagFix || *flagDiff {
+		if _, ok := p.(parser.PathParser); ok {
+			fmt.Printf("unable to fix %s changelogs\n", p.Name())
+			return codeRequestError
+		}
+
+		fixed, err := linter.Fix(source, parse, lintingConfig)
+		if err != nil {
+			fmt.Println(err)
+			return codeSyntaxError
+		}
+
+		if *flagDiff {
+			if bytes.Equal(fixed, source) {
+				return codeOK
+			}
+			fmt.Print(diff.Unified(inputFilename, inputFilename+" (fixed)", source, fixed))
+			return codeLintError // pending fixes
+		}
+
+		if !bytes.Equal(fixed, source) {
+			if err := writeFile(inputFilename, fixed); err != nil {
+				fmt.Println(err)
+				return codeRequestError
+			}
+			source = fixed
+		}
+	}
 
 	exitCode := codeOK
+	report := formatter.Report{Filename: inputFilename, Rules: config.AllRules()}
+
+	changes, err := parse(source)
+	if err != nil {
+		syntaxErrors, ok := err.(parser.SyntaxErrors)
+		if !ok || changes == nil {
+			fmt.Println(err)
+			return codeSyntaxError
+		}
+
+		report.SyntaxErrors = syntaxErrors
+		exitCode = codeSyntaxError
+	}
+
+	failures := make(chan linting.Failure)
+	report.EnabledRules = map[string]bool{}
+	for r := range lintingConfig.RuleArgs {
+		report.EnabledRules[r.Name()] = true
+	}
+	go linter.Lint(*changes, lintingConfig, failures)
 
 	for failure := range failures {
-		lineInfo := ""
-		if failure.Position > 0 {
-			lineInfo = fmt.Sprintf("(line %d)", failure.Position)
+		report.Failures = append(report.Failures, failure)
+	}
+
+	if *flagWriteBaseline {
+		if err := writeBaseline(*flagBaseline, report.Failures); err != nil {
+			fmt.Println(err)
+			return codeRequestError
+		}
+		fmt.Printf("%d failures recorded in %s\n", len(report.Failures), *flagBaseline)
+		return exitCode
+	}
+
+	if *flagBaseline != "" {
+		recorded, err := baseline.Load(*flagBaseline)
+		if err != nil {
+			fmt.Println(err)
+			return codeRequestError
 		}
-		fmt.Printf("%s: %s %s\n", failure.RuleName, failure.Message, lineInfo)
-		exitCode = codeLintError
+		report.Failures = recorded.Apply(report.Failures)
 	}
+
+	for _, failure := range report.Failures {
+		if exitCode == codeOK && failure.Severity.AtLeast(failOn) {
+			exitCode = codeLintError
+		}
+	}
+	sortFailures(report.Failures)
+
+	if err := outputFormatter.Format(os.Stdout, report); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
 	return exitCode
 }
 
+// parseInterspersed parses the flags of the arguments, flags can follow free arguments.
+// It returns the free arguments.
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	result := []string{}
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return result, nil
+		}
+		result = append(result, args[0])
+		args = args[1:]
+	}
+}
+
+// changelogFilename yields the changelog file name from the free arguments of the command line
+func changelogFilename(freeArgs []string) string {
+	if len(freeArgs) > 0 {
+		return freeArgs[0]
+	}
+
+	return "CHANGELOG.md"
+}
+
+// readChangelog yields the source of the changelog file.
+// Path parsers read the changelog by themselves, thus only the existence of the file is checked for them.
+func readChangelog(p parser.Parser, filename string) ([]byte, error) {
+	if _, ok := p.(parser.PathParser); ok {
+		_, err := os.Stat(filename)
+		return nil, err
+	}
+
+	return os.ReadFile(filename)
+}
+
+// newParseFunc yields a function parsing changelog sources with the given parser.
+// Path parsers ignore the source and parse the changelog at the given path.
+func newParseFunc(p parser.Parser, mainConfig *config.Config, filename string) (func([]byte) (*model.Changelog, error), error) {
+	parserConf, err := mainConfig.ParserConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	parse := func(source []byte) (*model.Changelog, error) {
+		return p.Parse(bytes.NewReader(source), parserConf)
+	}
+	if pathParser, ok := p.(parser.PathParser); ok {
+		parse = func([]byte) (*model.Changelog, error) {
+			return pathParser.ParsePath(filename, parserConf)
+		}
+	}
+
+	// parsed changelogs carry the configured versioning scheme for version-aware rules
+	return func(source []byte) (*model.Changelog, error) {
+		changes, err := parse(source)
+		if changes != nil {
+			changes.Versioning = mainConfig.Versioning.Scheme
+		}
+		return changes, err
+	}, nil
+}
+
+// writeFile overwrites the content of an existing file keeping its permissions
+func writeFile(filename string, content []byte) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, content, info.Mode().Perm())
+}
+
+// writeBaseline writes the baseline of the given failures in a file
+func writeBaseline(filename string, failures []linting.Failure) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := baseline.New(failures).Write(file); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// sortFailures sorts failures by position then by rule name to make the output deterministic
+func sortFailures(failures []linting.Failure) {
+	sort.SliceStable(failures, func(i, j int) bool {
+		fi, fj := failures[i], failures[j]
+		if fi.Position != fj.Position {
+			return fi.Position < fj.Position
+		}
+		if fi.Range.Start.Column != fj.Range.Start.Column {
+			return fi.Range.Start.Column < fj.Range.Start.Column
+		}
+		return fi.RuleName < fj.RuleName
+	})
+}
+
 func versionInfo() string {
 	var buildInfo string
 	if date != "unknown" && builtBy != "unknown" {
@@ -1,7 +1,14 @@
 package main
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/chavacava/changelog-lint/parser"
 )
 
 func TestRun(t *testing.T) {
@@ -41,10 +48,187 @@ func TestRun(t *testing.T) {
 			args: []string{"changelog-lint", "./testdata/parser-error.md"},
 			want: codeSyntaxError,
 		},
+		{
+			args: []string{"changelog-lint", "-format", "json", "./testdata/parser-error.md"},
+			want: codeSyntaxError,
+		},
+		{
+			args: []string{"changelog-lint", "-format", "sarif", "./testdata/keepachangelog.md"},
+			want: codeOK,
+		},
+		{
+			// the versioning scheme alone selects a version pattern accepting its versions
+			args: []string{"changelog-lint", "-config", "testdata/versioning/pep440.toml", "./testdata/versioning/pep440.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-config", "testdata/versioning/calver.toml", "./testdata/versioning/calver.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-config", "testdata/versioning/calver.toml", "./testdata/versioning/pep440.md"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "-format", "junit", "-release", "0.0.0"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "-diff", "./testdata/keepachangelog.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-diff", "./linting/rule/testdata/subsection-order.md"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "fmt", "-check", "./render/testdata/formatted/unformatted.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "fmt", "-check", "./render/testdata/unformatted.md"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "fmt", "./testdata/parser-error.md"},
+			want: codeSyntaxError,
+		},
+		{
+			args: []string{"changelog-lint", "export", "-format", "yaml", "./export/testdata/CHANGELOG.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "export", "-format-in", "debian", "./parser/testdata/debian/changelog"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "export", "-format", "toml", "./export/testdata/CHANGELOG.md"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "export", "./testdata/parser-error.md"},
+			want: codeSyntaxError,
+		},
+		{
+			args: []string{"changelog-lint", "import", "./export/testdata/CHANGELOG.json"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "import", "-format", "yaml", "./export/testdata/CHANGELOG.yaml"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "import", "./export/testdata/CHANGELOG.yaml"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "import", "./unknown.json"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "notes", "latest", "./testdata/notes.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "notes", "-format", "plain", "1.0.0", "./testdata/notes.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "notes", "-format-in", "fragments", "unreleased", "./parser/testdata/fragments"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "notes", "9.9.9", "./testdata/notes.md"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "notes", "-format", "html", "latest", "./testdata/notes.md"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "notes"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "notes", "latest", "./testdata/parser-error.md"},
+			want: codeSyntaxError,
+		},
+		{
+			args: []string{"changelog-lint", "-config", "./testdata/warnings.toml", "./linting/rule/testdata/subsection-order.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-config", "./testdata/warnings.toml", "-fail-on", "warning", "./linting/rule/testdata/subsection-order.md"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "-fail-on", "fatal"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "-format", "unknown"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "markdown", "./testdata/keepachangelog.md"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "fragments", "./parser/testdata/fragments"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "fragments", "-fix", "./parser/testdata/fragments"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "fragments", "./unknown/fragments"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "debian", "./parser/testdata/debian/changelog"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "debian", "./linting/rule/testdata/debian/urgency"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "gnu-changelog", "./parser/testdata/gnu/ChangeLog"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "gnu-news", "./linting/rule/testdata/gnu/NEWS"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "rst", "./linting/rule/testdata/fixed/outline/version-order.rst"},
+			want: codeOK,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "asciidoc", "./linting/rule/testdata/outline/subsection-order.adoc"},
+			want: codeLintError,
+		},
+		{
+			args: []string{"changelog-lint", "-format-in", "unknown"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "-write-baseline"},
+			want: codeRequestError,
+		},
+		{
+			args: []string{"changelog-lint", "-baseline", "unknown-baseline.json"},
+			want: codeRequestError,
+		},
 		{
 			args: []string{"changelog-lint", "./testdata/keepachangelog.md"},
 			want: codeOK,
 		},
+		{
+			args: []string{"changelog-lint", "./testdata/suppressed.md"},
+			want: codeOK,
+		},
 		{
 			args: []string{"changelog-lint", "-config", "./testdata/changelog-maker-changelog.conf.toml", "./testdata/changelog-maker-changelog.md"},
 			want: codeOK,
@@ -58,3 +242,345 @@ func TestRun(t *testing.T) {
 	}
 
 }
+
+func TestRunFix(t *testing.T) {
+	testCases := []struct {
+		source string
+		want   string
+	}{
+		{"./linting/rule/testdata/subsection-order.md", "./linting/rule/testdata/fixed/subsection-order.md"},
+		{"./testdata/suppressed.md", "./testdata/suppressed.md"}, // suppressed failures are not fixed
+	}
+
+	for _, tc := range testCases {
+		source, err := os.ReadFile(tc.source)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		if err := os.WriteFile(filename, source, 0o644); err != nil {
+			t.Fatalf("error writing test data: %v", err)
+		}
+
+		if got := run([]string{"changelog-lint", "-fix", filename}); got != codeOK {
+			t.Fatalf("%s: expected %d after fixing, got %d", tc.source, codeOK, got)
+		}
+
+		want, err := os.ReadFile(tc.want)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatalf("error reading fixed file: %v", err)
+		}
+		if string(got) != string(want) {
+			t.Fatalf("%s: expected fixed changelog:\n%s\ngot:\n%s", tc.source, want, got)
+		}
+	}
+}
+
+func TestRunBaseline(t *testing.T) {
+	changelog := "./linting/rule/testdata/subsection-repetition.md"
+	baselineFile := filepath.Join(t.TempDir(), "baseline.json")
+
+	if got := run([]string{"changelog-lint", changelog}); got != codeLintError {
+		t.Fatalf("expected %d without baseline, got %d", codeLintError, got)
+	}
+	if got := run([]string{"changelog-lint", "-baseline", baselineFile, "-write-baseline", changelog}); got != codeOK {
+		t.Fatalf("expected %d when writing the baseline, got %d", codeOK, got)
+	}
+	if got := run([]string{"changelog-lint", "-baseline", baselineFile, changelog}); got != codeOK {
+		t.Fatalf("expected %d with baseline, got %d", codeOK, got)
+	}
+	// recorded failures that no longer occur are warnings
+	if got := run([]string{"changelog-lint", "-baseline", baselineFile, "-fail-on", "warning", "./testdata/keepachangelog.md"}); got != codeLintError {
+		t.Fatalf("expected %d with a stale baseline, got %d", codeLintError, got)
+	}
+}
+
+func TestNotes(t *testing.T) {
+	testCases := []struct {
+		version, format string
+		want            string
+	}{
+		{"unreleased", notesFormatSource, "### Added\n\n- Release notes\n  of a single version\n"},
+		{"latest", notesFormatSource, "### Added\n\n* Export command\n\n### Fixed\n\n- Crash on  empty files\n"},
+		{"v1.1.0", notesFormatMarkdown, "### Added\n\n- Export command\n\n### Fixed\n\n- Crash on  empty files\n"},
+		{"1.1.0", notesFormatPlain, "Added:\n- Export command\n\nFixed:\n- Crash on empty files\n"},
+		{"1.0.0", notesFormatJSON, `"summary": "- First release"`},
+	}
+
+	source, err := os.ReadFile("./testdata/notes.md")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+	changes, err := parser.Default{}.Parse(bytes.NewReader(source), &parser.Config{
+		TitlePattern:      regexp.MustCompile(`.+`),
+		VersionPattern:    regexp.MustCompile(`^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$`),
+		SubsectionPattern: regexp.MustCompile(`^### ([A-Z]+[a-z]+)[ ]*$`),
+		EntryPattern:      regexp.MustCompile(`^[*-] .+$`),
+	})
+	if err != nil {
+		t.Fatalf("unexpected parsing error: %v", err)
+	}
+
+	for _, tc := range testCases {
+		version, err := findVersion(changes, tc.version)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.version, err)
+		}
+
+		var got bytes.Buffer
+		if err := writeNotes(&got, *version, source, tc.format); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.version, err)
+		}
+		if tc.format == notesFormatJSON && !strings.Contains(got.String(), tc.want) || tc.format != notesFormatJSON && got.String() != tc.want {
+			t.Errorf("%s (%s): expected notes:\n%s\ngot:\n%s", tc.version, tc.format, tc.want, got.String())
+		}
+	}
+}
+
+func TestRunRelease(t *testing.T) {
+	testCases := []struct {
+		args []string
+		want int
+	}{
+		{[]string{"release", "1.1.0", "--date", "2026-10-18"}, codeOK},
+		{[]string{"release", "-date", "2026-10-18", "v1.1.0"}, codeOK},
+		{[]string{"release", "0.9.0"}, codeLintError}, // versions would not be sorted
+		{[]string{"release", "1.0.0"}, codeRequestError},
+		{[]string{"release", "v1.0.0+build.1"}, codeRequestError}, // same version as 1.0.0
+		{[]string{"release", "1.1"}, codeRequestError},
+		{[]string{"release", "1.1.0", "--date", "18/10/2026"}, codeRequestError},
+	}
+
+	source, err := os.ReadFile("./testdata/release/CHANGELOG.md")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+	released, err := os.ReadFile("./testdata/release/CHANGELOG-released.md")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+
+	for _, tc := range testCases {
+		filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		if err := os.WriteFile(filename, source, 0o644); err != nil {
+			t.Fatalf("error writing test data: %v", err)
+		}
+
+		args := append([]string{"changelog-lint"}, tc.args...)
+		if got := run(append(args, filename)); got != tc.want {
+			t.Fatalf("expected %d for %v, got %d", tc.want, tc.args, got)
+		}
+
+		want := source
+		if tc.want == codeOK {
+			want = released
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatalf("error reading released file: %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%v: expected changelog:\n%s\ngot:\n%s", tc.args, want, got)
+		}
+	}
+
+	if got := run([]string{"changelog-lint", "release"}); got != codeRequestError {
+		t.Errorf("expected %d without version, got %d", codeRequestError, got)
+	}
+	// the released changelog has no Unreleased changes to release
+	if got := run([]string{"changelog-lint", "release", "1.2.0", "./testdata/keepachangelog.md"}); got != codeLintError {
+		t.Errorf("expected %d when releasing an empty Unreleased version, got %d", codeLintError, got)
+	}
+}
+
+func TestRunReleaseHeadingStyle(t *testing.T) {
+	// released headings follow the style of the existing ones
+	testCases := []struct {
+		source, want string
+		crlf         bool // test data converted to CRLF line endings
+	}{
+		{"./testdata/release/CHANGELOG.md", "./testdata/release/CHANGELOG-released.md", false},
+		{"./testdata/release/CHANGELOG.md", "./testdata/release/CHANGELOG-released.md", true},
+		{"./testdata/release/CHANGELOG-unbracketed.md", "./testdata/release/CHANGELOG-unbracketed-released.md", false},
+	}
+
+	for _, tc := range testCases {
+		source, err := os.ReadFile(tc.source)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		if tc.crlf {
+			source = bytes.ReplaceAll(source, []byte("\n"), []byte("\r\n"))
+		}
+		filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		if err := os.WriteFile(filename, source, 0o644); err != nil {
+			t.Fatalf("error writing test data: %v", err)
+		}
+
+		if got := run([]string{"changelog-lint", "release", "-date", "2026-10-18", "1.1.0", filename}); got != codeOK {
+			t.Fatalf("%s: expected %d, got %d", tc.source, codeOK, got)
+		}
+
+		want, err := os.ReadFile(tc.want)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		if tc.crlf {
+			want = bytes.ReplaceAll(want, []byte("\n"), []byte("\r\n"))
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatalf("error reading released file: %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s (crlf: %v): expected changelog:\n%q\ngot:\n%q", tc.source, tc.crlf, want, got)
+		}
+	}
+}
+
+func TestRunReleaseVersioning(t *testing.T) {
+	testCases := []struct {
+		version string
+		want    int
+	}{
+		{"2026.10.0", codeOK},
+		{"2026.09.1", codeLintError}, // versions would not be sorted
+		{"2026.09.2", codeRequestError},
+		{"2026.10", codeRequestError},
+		{"v2026.10.0", codeRequestError},
+		{"1.1.0", codeRequestError},
+	}
+
+	source, err := os.ReadFile("./testdata/release/CHANGELOG-calver.md")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+	released, err := os.ReadFile("./testdata/release/CHANGELOG-calver-released.md")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+
+	for _, tc := range testCases {
+		filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		if err := os.WriteFile(filename, source, 0o644); err != nil {
+			t.Fatalf("error writing test data: %v", err)
+		}
+
+		args := []string{"changelog-lint", "release", "-config", "./testdata/release/calver.toml", "-date", "2026-10-18", tc.version, filename}
+		if got := run(args); got != tc.want {
+			t.Fatalf("expected %d for %s, got %d", tc.want, tc.version, got)
+		}
+
+		want := source
+		if tc.want == codeOK {
+			want = released
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatalf("error reading released file: %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s: expected changelog:\n%s\ngot:\n%s", tc.version, want, got)
+		}
+	}
+}
+
+func TestRunAdd(t *testing.T) {
+	testCases := []struct {
+		source string
+		adds   [][]string
+		want   string
+		crlf   bool // test data converted to CRLF line endings
+	}{
+		{
+			source: "./testdata/release/CHANGELOG.md",
+			adds: [][]string{
+				{"add", "--type", "fixed", "Crash on start"},
+				{"add", "-type", "Changed", "New layout"},
+				{"add", "-type", "Added", "Another one"},
+			},
+			want: "./testdata/add/CHANGELOG-added.md",
+		},
+		{
+			source: "./testdata/add/CHANGELOG-released.md",
+			adds:   [][]string{{"add", "-type", "Security", "Patch\nsecond line"}},
+			want:   "./testdata/add/CHANGELOG-released-added.md",
+		},
+		{
+			source: "./testdata/add/CHANGELOG-compact.md",
+			adds: [][]string{
+				{"add", "-type", "Added", "Compact entry"},
+				{"add", "-type", "Fixed", "Crash on start"},
+				{"add", "-type", "Added", "Another one"},
+			},
+			want: "./testdata/add/CHANGELOG-compact-added.md",
+		},
+		{
+			source: "./testdata/add/CHANGELOG-compact.md",
+			adds: [][]string{
+				{"add", "-type", "Added", "Compact entry"},
+				{"add", "-type", "Fixed", "Crash on start"},
+				{"add", "-type", "Added", "Another one"},
+			},
+			want: "./testdata/add/CHANGELOG-compact-added.md",
+			crlf: true,
+		},
+		{
+			source: "./testdata/add/CHANGELOG-released.md",
+			adds:   [][]string{{"add", "-type", "Security", "Patch\nsecond line"}},
+			want:   "./testdata/add/CHANGELOG-released-added.md",
+			crlf:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		source, err := os.ReadFile(tc.source)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		if tc.crlf {
+			source = bytes.ReplaceAll(source, []byte("\n"), []byte("\r\n"))
+		}
+		filename := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		if err := os.WriteFile(filename, source, 0o644); err != nil {
+			t.Fatalf("error writing test data: %v", err)
+		}
+
+		for _, add := range tc.adds {
+			if got := run(append(append([]string{"changelog-lint"}, add...), filename)); got != codeOK {
+				t.Fatalf("expected %d for %v, got %d", codeOK, add, got)
+			}
+		}
+		// added entries keep the changelog clean
+		if got := run([]string{"changelog-lint", filename}); got != codeOK {
+			t.Fatalf("%s: expected %d when linting the updated changelog, got %d", tc.source, codeOK, got)
+		}
+		// unknown subsections are refused
+		if got := run([]string{"changelog-lint", "add", "-type", "Infrastructure", "message", filename}); got != codeRequestError {
+			t.Fatalf("expected %d for an unknown subsection, got %d", codeRequestError, got)
+		}
+
+		want, err := os.ReadFile(tc.want)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		if tc.crlf {
+			want = bytes.ReplaceAll(want, []byte("\n"), []byte("\r\n"))
+		}
+		got, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatalf("error reading updated file: %v", err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s (crlf: %v): expected changelog:\n%q\ngot:\n%q", tc.source, tc.crlf, want, got)
+		}
+	}
+
+	if got := run([]string{"changelog-lint", "add", "message"}); got != codeRequestError {
+		t.Errorf("expected %d without type, got %d", codeRequestError, got)
+	}
+}
@@ -6,10 +6,26 @@ package model
 // versions that are tracked in the changelog. For supported formats, see
 // the documentation for Version.
 type Changelog struct {
-	Header   []string
-	Versions []*Version
+	Format     string // Name of the format of the changelog source, e.g. markdown
+	DateLayout string // Layout of the release dates of versions (see time.Parse), empty if the format has no dates
+	Versioning string // Name of the versioning scheme of the versions (see versioning.New), empty for the default one
+	// How versions of the changelog format are ordered, empty if they follow the versioning scheme (see the OrderBy constants)
+	VersionOrdering string
+	// True if subsections are groups of changes named after their authors instead of kinds of changes, like in Debian changelogs
+	AuthorSubsections bool
+	Header            []string // Lines before the first version, empty lines separate paragraphs
+	Versions          []*Version
+	Directives        []*Directive // Linter directives found in the changelog, in source order
+	Links             []*Link      // Link reference definitions found in the changelog, in source order
 }
 
+// Version orderings of changelog formats
+const (
+	OrderByDpkgVersions     = "dpkg"     // versions are compared as dpkg does, like the ones of Debian changelogs
+	OrderByUpstreamVersions = "upstream" // free-form versions are compared like Debian upstream versions, like the ones of GNU NEWS files
+	OrderByDates            = "date"     // versions are dates and authors, not releases, ordered by date, like the entries of GNU ChangeLogs
+)
+
 // NewChangelog creates a pristine Changelog.
 func NewChangelog() *Changelog {
 	return &Changelog{Versions: []*Version{}}
@@ -21,7 +37,18 @@ type Version struct {
 	Version     string
 	Subsections []*Subsection
 	SourceLine  string
-	Position    int // Line number in the changelog
+	Position    int              // Line number in the changelog
+	Range       Range            // Source range of the version heading
+	NameRange   Range            // Source range of the version name in the heading
+	Date        string           // Release date as written in the changelog, empty if the version has no date
+	DateRange   Range            // Source range of the release date
+	Metadata    map[string]Field // Additional information about the version, like the urgency of Debian releases or the values of named groups of the version pattern
+}
+
+// Field is a piece of information found in the source of a changelog
+type Field struct {
+	Value string
+	Range Range // Source range of the value
 }
 
 // Subsection contains the data for a given subsection.
@@ -29,12 +56,47 @@ type Subsection struct {
 	Name       string
 	History    []*Entry
 	SourceLine string
-	Position   int // Line number in the changelog
+	Position   int              // Line number in the changelog
+	Range      Range            // Source range of the subsection heading
+	NameRange  Range            // Source range of the subsection name in the heading
+	Metadata   map[string]Field // Additional information about the subsection, like the values of named groups of the subsection pattern
 }
 
 // Entry contains the data for a single change.
 type Entry struct {
 	// What the change entails.
 	Summary  string
-	Position int // Line number in the changelog
+	Lines    []string         // Source lines of the entry, empty lines separate paragraphs
+	Position int              // Line number in the changelog
+	Range    Range            // Source range of the entry, continuation lines included
+	Metadata map[string]Field // Additional information about the entry, like the values of named groups of the entry pattern
+}
+
+// Extent yields the source range of the version, from its heading to the end of its last subsection
+// or of its last metadata field or its date when they follow the subsections (like the trailer of Debian releases).
+func (v Version) Extent() Range {
+	result := v.Range
+	if len(v.Subsections) > 0 {
+		result.End = v.Subsections[len(v.Subsections)-1].Extent().End
+	}
+	for _, field := range v.Metadata {
+		if field.Range.End.Offset > result.End.Offset {
+			result.End = field.Range.End
+		}
+	}
+	if v.DateRange.End.Offset > result.End.Offset {
+		result.End = v.DateRange.End
+	}
+
+	return result
+}
+
+// Extent yields the source range of the subsection, from its heading to the end of its last entry.
+func (s Subsection) Extent() Range {
+	result := s.Range
+	if len(s.History) > 0 {
+		result.End = s.History[len(s.History)-1].Range.End
+	}
+
+	return result
 }
@@ -0,0 +1,17 @@
+package model
+
+// Kinds of directives
+const (
+	DirectiveDisable         = "disable"           // disables rules until enabled again
+	DirectiveDisableNextLine = "disable-next-line" // disables rules on the next line only
+	DirectiveEnable          = "enable"            // enables rules disabled by a previous directive
+)
+
+// Directive is an instruction to the linter written in the changelog,
+// for example <!-- changelog-lint-disable version-order -->
+type Directive struct {
+	Kind     string
+	Rules    []string // names of the rules targeted by the directive, empty means all rules
+	Position int      // Line number in the changelog
+	Range    Range    // Source range of the directive
+}
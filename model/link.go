@@ -0,0 +1,28 @@
+package model
+
+import "regexp"
+
+// Link is a link reference definition of a Markdown changelog,
+// for example [1.0.0]: https://github.com/owner/repo/compare/v0.9.0...v1.0.0
+type Link struct {
+	Label    string // Label of the link, without brackets
+	URL      string
+	Title    string // Optional title following the URL, with its quotes
+	Position int    // Line number in the changelog
+	Range    Range  // Source range of the definition
+	URLRange Range  // Source range of the URL
+}
+
+// compareURLPattern matches compare URLs like https://github.com/owner/repo/compare/v0.9.0...v1.0.0
+var compareURLPattern = regexp.MustCompile(`^(\S*/compare/)(\S+?)\.\.\.(\S+)$`)
+
+// Compare splits the URL of the link if it is a compare URL, like the ones of GitHub, GitLab or Bitbucket:
+// base is the URL up to the compared references, from and to are the compared references (tags, branches or commits).
+func (l Link) Compare() (base, from, to string, ok bool) {
+	matches := compareURLPattern.FindStringSubmatch(l.URL)
+	if matches == nil {
+		return "", "", "", false
+	}
+
+	return matches[1], matches[2], matches[3], true
+}
@@ -0,0 +1,20 @@
+package model
+
+// Location is a point in the changelog source.
+type Location struct {
+	Line   int // Line number, starting at 1
+	Column int // Column number counted in runes, starting at 1
+	Offset int // Byte offset from the beginning of the source, starting at 0
+}
+
+// Range is a portion of the changelog source.
+// Start is inclusive, End is exclusive.
+type Range struct {
+	Start Location
+	End   Location
+}
+
+// IsZero returns true if the range does not point to any portion of the source.
+func (r Range) IsZero() bool {
+	return r.Start.Line == 0
+}
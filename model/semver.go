@@ -0,0 +1,126 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a semantic version as specified by https://semver.org/spec/v2.0.0.html
+type SemVer struct {
+	Major, Minor, Patch uint64
+	PreRelease          []string // Pre-release identifiers, like rc and 1 for 1.0.0-rc.1, empty for releases
+	Build               []string // Build metadata identifiers, ignored by the precedence of versions
+}
+
+// semVerPattern matches semantic versions with an optional v prefix, see https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
+var semVerPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// ParseSemVer parses a semantic version like 1.2.0, 1.2.0-rc.1 or 1.2.0+build.5, the v prefix of v1.2.0 is allowed
+func ParseSemVer(s string) (SemVer, error) {
+	matches := semVerPattern.FindStringSubmatch(s)
+	if matches == nil {
+		return SemVer{}, fmt.Errorf("%q is not a semantic version", s)
+	}
+
+	result := SemVer{}
+	for i, field := range []*uint64{&result.Major, &result.Minor, &result.Patch} {
+		value, err := strconv.ParseUint(matches[i+1], 10, 64)
+		if err != nil {
+			return SemVer{}, fmt.Errorf("%q is not a semantic version: %v", s, err)
+		}
+		*field = value
+	}
+	if matches[4] != "" {
+		result.PreRelease = strings.Split(matches[4], ".")
+	}
+	if matches[5] != "" {
+		result.Build = strings.Split(matches[5], ".")
+	}
+
+	return result, nil
+}
+
+// String yields the version without v prefix, like 1.2.0-rc.1+build.5
+func (v SemVer) String() string {
+	result := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.PreRelease) > 0 {
+		result += "-" + strings.Join(v.PreRelease, ".")
+	}
+	if len(v.Build) > 0 {
+		result += "+" + strings.Join(v.Build, ".")
+	}
+
+	return result
+}
+
+// Compare yields -1, 0 or 1 if the precedence of v is lower, equal or higher than the one of other.
+// Pre-releases have a lower precedence than the release, build metadata are ignored.
+func (v SemVer) Compare(other SemVer) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			return compareUints(pair[0], pair[1])
+		}
+	}
+
+	switch {
+	case len(v.PreRelease) == 0 && len(other.PreRelease) == 0:
+		return 0
+	case len(v.PreRelease) == 0:
+		return 1
+	case len(other.PreRelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.PreRelease) && i < len(other.PreRelease); i++ {
+		if c := comparePreReleaseIdentifiers(v.PreRelease[i], other.PreRelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	// a larger set of pre-release identifiers has a higher precedence when all the preceding identifiers are equal
+	return compareUints(uint64(len(v.PreRelease)), uint64(len(other.PreRelease)))
+}
+
+// comparePreReleaseIdentifiers compares pre-release identifiers: numeric identifiers are compared numerically
+// and have a lower precedence than alphanumeric ones, compared lexically in ASCII sort order
+func comparePreReleaseIdentifiers(id1, id2 string) int {
+	numeric1, numeric2 := isNumeric(id1), isNumeric(id2)
+	switch {
+	case numeric1 && numeric2:
+		if len(id1) != len(id2) { // numeric identifiers have no leading zeros
+			return compareUints(uint64(len(id1)), uint64(len(id2)))
+		}
+		return strings.Compare(id1, id2)
+	case numeric1:
+		return -1
+	case numeric2:
+		return 1
+	default:
+		return strings.Compare(id1, id2)
+	}
+}
+
+func isNumeric(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func compareUints(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
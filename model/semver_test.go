@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+func TestParseSemVer(t *testing.T) {
+	testCases := []struct {
+		version string
+		want    string // canonical form, empty if the version is malformed
+	}{
+		{"1.2.3", "1.2.3"},
+		{"v1.2.3", "1.2.3"},
+		{"1.2.3-rc.1", "1.2.3-rc.1"},
+		{"1.2.3-alpha-1.x+build.5", "1.2.3-alpha-1.x+build.5"},
+		{"1.2.3+20221119", "1.2.3+20221119"},
+		{"1.2", ""},
+		{"01.2.3", ""},
+		{"1.2.3-01", ""},
+		{"1.2.3-", ""},
+		{"1.2.3+", ""},
+		{"V1.2.3", ""},
+		{"18446744073709551616.0.0", ""},
+	}
+
+	for _, tc := range testCases {
+		got, err := model.ParseSemVer(tc.version)
+		switch {
+		case tc.want == "" && err == nil:
+			t.Errorf("%s: expected an error, got %v", tc.version, got)
+		case tc.want != "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", tc.version, err)
+		case tc.want != "" && got.String() != tc.want:
+			t.Errorf("%s: expected %s, got %s", tc.version, tc.want, got)
+		}
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	// versions of increasing precedence, from https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0", "10.0.0",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			v1, _ := model.ParseSemVer(ordered[i])
+			v2, _ := model.ParseSemVer(ordered[j])
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			if got := v1.Compare(v2); got != want {
+				t.Errorf("comparing %s and %s: expected %d, got %d", ordered[i], ordered[j], want, got)
+			}
+		}
+	}
+
+	v1, _ := model.ParseSemVer("1.0.0+build.1")
+	v2, _ := model.ParseSemVer("v1.0.0+build.2")
+	if got := v1.Compare(v2); got != 0 {
+		t.Errorf("expected build metadata to be ignored, got %d", got)
+	}
+}
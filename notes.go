@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/config"
+	"github.com/chavacava/changelog-lint/export"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/render"
+)
+
+// Output formats of release notes
+const (
+	notesFormatSource   = "source"
+	notesFormatMarkdown = "markdown"
+	notesFormatPlain    = "plain"
+	notesFormatJSON     = "json"
+)
+
+// Aliases of versions
+const (
+	aliasLatest     = "latest"     // the newest released version
+	aliasUnreleased = "unreleased" // the Unreleased version
+)
+
+// runNotes prints the release notes of a version: its subsections and entries
+func runNotes(args []string) int {
+	flags := flag.NewFlagSet(args[0]+" notes", flag.ExitOnError)
+	flagConfig := flags.String("config", "", "set linter configuration")
+	flagFormatIn := flags.String("format-in", "", "set the changelog format, overrides the configuration")
+	flagFormat := flags.String("format", notesFormatSource, "set the output format ("+strings.Join([]string{notesFormatSource, notesFormatMarkdown, notesFormatPlain, notesFormatJSON}, ", ")+")")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s notes [flags] <version|%s|%s> [changelog]\n", args[0], aliasLatest, aliasUnreleased)
+		flags.PrintDefaults()
+	}
+
+	freeArgs, err := parseInterspersed(flags, args[2:])
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if len(freeArgs) < 1 {
+		flags.Usage()
+		return codeRequestError
+	}
+
+	mainConfig, err := config.LoadConfig(*flagConfig)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if *flagFormatIn != "" {
+		mainConfig.Parser.Format = *flagFormatIn
+	}
+
+	p, err := mainConfig.ChangelogParser()
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	inputFilename := changelogFilename(freeArgs[1:])
+	source, err := readChangelog(p, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	parse, err := newParseFunc(p, mainConfig, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	changes, err := parse(source)
+	if err != nil {
+		fmt.Println(err)
+		return codeSyntaxError
+	}
+
+	version, err := findVersion(changes, freeArgs[0])
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	if err := writeNotes(os.Stdout, *version, source, *flagFormat); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	return codeOK
+}
+
+// findVersion yields the version of the changelog with the given name or alias (latest or unreleased)
+func findVersion(changes *model.Changelog, name string) (*model.Version, error) {
+	for _, v := range changes.Versions {
+		switch {
+		case strings.EqualFold(name, aliasLatest) && v.Version != "Unreleased",
+			strings.EqualFold(name, aliasUnreleased) && v.Version == "Unreleased",
+			v.Version == name, v.Version == strings.TrimPrefix(name, "v"):
+			return v, nil
+		}
+	}
+
+	switch {
+	case strings.EqualFold(name, aliasLatest):
+		return nil, fmt.Errorf("the changelog has no released version")
+	case strings.EqualFold(name, aliasUnreleased):
+		return nil, fmt.Errorf("the changelog has no Unreleased version")
+	default:
+		return nil, fmt.Errorf("version %s not found in the changelog", name)
+	}
+}
+
+// writeNotes writes the release notes of the version in the given format.
+// Notes of changelogs without source (like change fragments) are rendered in Markdown instead of being copied from the source.
+func writeNotes(w io.Writer, version model.Version, source []byte, format string) error {
+	switch format {
+	case notesFormatSource:
+		if source == nil {
+			return render.Markdown{}.RenderNotes(w, version)
+		}
+		_, err := io.WriteString(w, strings.Trim(notesSource(version, source), "\n")+"\n")
+		return err
+	case notesFormatMarkdown:
+		return render.Markdown{}.RenderNotes(w, version)
+	case notesFormatPlain:
+		return render.Plain{}.RenderNotes(w, version)
+	case notesFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(export.NewVersion(version))
+	default:
+		return fmt.Errorf("unknown notes format %q, available formats are: %s, %s, %s, %s", format, notesFormatSource, notesFormatMarkdown, notesFormatPlain, notesFormatJSON)
+	}
+}
+
+// notesSource yields the source of the version from the end of its heading to the end of its last subsection
+func notesSource(version model.Version, source []byte) string {
+	start, end := version.Range.End.Offset, version.Range.End.Offset
+	if len(version.Subsections) > 0 {
+		end = version.Subsections[len(version.Subsections)-1].Extent().End.Offset
+	}
+
+	return string(source[start:end])
+}
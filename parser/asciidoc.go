@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// AsciiDoc parses AsciiDoc changelogs (CHANGELOG.adoc):
+//
+//	= Changelog
+//
+//	== 1.2.0 (2022-11-19)
+//
+//	=== Added
+//
+//	* Change description
+//	continued
+//
+// Versions are the headings of the level of the first heading containing a version,
+// subsections are the headings of the level below, and top level list items are entries.
+// Comments, block anchors and block attributes are ignored.
+type AsciiDoc struct{}
+
+var (
+	asciiDocHeadingPattern = regexp.MustCompile(`^(=+)\s+(.*?)\s*$`)
+	asciiDocItemPattern    = regexp.MustCompile(`^[*-]\s`)
+	// asciiDocBlockLinePattern matches block anchors and attribute lists like [[v1.2.0]] or [#v1.2.0]
+	asciiDocBlockLinePattern = regexp.MustCompile(`^\[.*\]\s*$`)
+)
+
+// Name yields the name of the format of the changelogs handled by the parser
+func (AsciiDoc) Name() string {
+	return "asciidoc"
+}
+
+// Parse parses an AsciiDoc changelog.
+// Syntax errors do not stop the parsing: the parser skips lines until the next version or subsection.
+func (p AsciiDoc) Parse(r io.Reader, _ *Config) (*model.Changelog, error) {
+	return outline(p.Name(), p.lines(scanLines(newLineScanner(r))))
+}
+
+// lines yields the outline of the given source lines, comments and empty lines are dropped
+func (AsciiDoc) lines(source []token) []outlineLine {
+	result := []outlineLine{}
+	inCommentBlock := false
+	for _, tok := range source {
+		line := tok.fullText
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(line, "////") {
+			inCommentBlock = !inCommentBlock
+			continue
+		}
+		if inCommentBlock || trimmed == "" || strings.HasPrefix(line, "//") || asciiDocBlockLinePattern.MatchString(line) {
+			continue
+		}
+
+		if matches := asciiDocHeadingPattern.FindStringSubmatchIndex(line); matches != nil {
+			result = append(result, outlineLine{
+				tok: tok,
+				heading: &heading{
+					level:    matches[3] - matches[2],
+					start:    matches[4],
+					end:      matches[5],
+					extent:   tok.lineRange(),
+					lastLine: tok.pos,
+				},
+			})
+			continue
+		}
+
+		result = append(result, outlineLine{tok: tok, item: asciiDocItemPattern.MatchString(line), indented: isIndented(line)})
+	}
+
+	return result
+}
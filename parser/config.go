@@ -8,4 +8,17 @@ type Config struct {
 	VersionPattern    *regexp.Regexp
 	SubsectionPattern *regexp.Regexp
 	EntryPattern      *regexp.Regexp
+	DateLayout        string // layout of release dates (see time.Parse), defaults to YYYY-MM-DD
+}
+
+// defaultDateLayout is the layout of release dates when none is configured
+const defaultDateLayout = "2006-01-02"
+
+// dateLayout yields the configured layout of release dates
+func (c *Config) dateLayout() string {
+	if c == nil || c.DateLayout == "" {
+		return defaultDateLayout
+	}
+
+	return c.DateLayout
 }
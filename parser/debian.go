@@ -0,0 +1,208 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// Debian parses Debian changelogs (debian/changelog):
+//
+//	package (1.2-1) unstable; urgency=medium
+//
+//	  [ Group ]
+//	  * Change description
+//	    continued
+//
+//	 -- Maintainer Name <maintainer@example.com>  Mon, 02 Jan 2023 10:00:00 +0100
+//
+// Entries are gathered in subsections named after their group, entries outside any group are in an unnamed subsection.
+// The date of the trailer is the date of the version. The package, the distribution
+// and the options (like urgency) of the heading and the maintainer of the trailer are metadata of the version.
+type Debian struct{}
+
+var (
+	debianHeadingPattern = regexp.MustCompile(`^(\S+) \(([^ ()]+)\) ([^;]*);(.*)$`)
+	debianTrailerPattern = regexp.MustCompile(`^ -- (.+<[^>]*>)  (.*)$`)
+	// debianEndPattern matches lines after which the content of the changelog is ignored
+	debianEndPattern = regexp.MustCompile(`^(Local variables:|Old Changelog:)`)
+)
+
+// Name yields the name of the format of the changelogs handled by the parser
+func (Debian) Name() string {
+	return "debian"
+}
+
+// Parse parses a Debian changelog.
+// Syntax errors do not stop the parsing: the parser skips lines until the next release heading.
+func (p Debian) Parse(r io.Reader, _ *Config) (*model.Changelog, error) {
+	result := model.NewChangelog()
+	result.Format = p.Name()
+	result.DateLayout = time.RFC1123Z
+	result.VersionOrdering = model.OrderByDpkgVersions
+	result.AuthorSubsections = true
+	errs := SyntaxErrors{}
+	var currentVersion *model.Version
+	var currentSubsection *model.Subsection
+	var currentEntry *model.Entry
+	inRelease := false // true between a release heading and its trailer
+	skipping := false  // true after a syntax error, until the next release heading
+	previousLine := 0  // line number of the last non-empty line
+
+	// missingTrailer records a syntax error for the current release if it has no trailer
+	missingTrailer := func() {
+		if inRelease {
+			errs = append(errs, SyntaxError{
+				Message:  fmt.Sprintf("version %s has no trailer", currentVersion.Version),
+				Position: currentVersion.Position,
+				Range:    currentVersion.Range,
+			})
+		}
+	}
+
+	scanner := newLineScanner(r)
+	pos := 0
+	for scanner.Scan() {
+		pos++
+		tok := token{fullText: scanner.Text(), pos: pos, offset: scanner.offset}
+		line := tok.fullText
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		gap := pos > previousLine+1
+		previousLine = pos
+
+		switch {
+		case debianEndPattern.MatchString(line):
+			missingTrailer()
+			return partialResult(result, errs)
+		case !strings.HasPrefix(line, " "):
+			missingTrailer()
+			currentVersion, currentSubsection, currentEntry = nil, nil, nil
+			version, vErrs := p.version(tok)
+			errs = append(errs, vErrs...)
+			inRelease, skipping = version != nil, version == nil
+			if version != nil {
+				result.Versions = append(result.Versions, version)
+				currentVersion = version
+			}
+		case skipping:
+			continue
+		case strings.HasPrefix(line, " --"):
+			matches := debianTrailerPattern.FindStringSubmatchIndex(line)
+			if !inRelease || matches == nil {
+				errs = append(errs, SyntaxError{
+					Message:  fmt.Sprintf("unexpected line: %s\nexpecting a release heading or a trailer like ' -- Name <email>  date'", line),
+					Position: pos,
+					Range:    tok.lineRange(),
+				})
+				inRelease, skipping = false, true
+				continue
+			}
+			currentVersion.Metadata["maintainer"] = lineField(tok, matches[2], matches[3])
+			date := lineField(tok, matches[4], matches[5])
+			currentVersion.Date, currentVersion.DateRange = date.Value, date.Range
+			inRelease = false
+			currentSubsection, currentEntry = nil, nil
+		case !inRelease || !strings.HasPrefix(line, "  "):
+			errs = append(errs, SyntaxError{
+				Message:  fmt.Sprintf("unexpected line: %s\nexpecting a release heading", line),
+				Position: pos,
+				Range:    tok.lineRange(),
+			})
+			inRelease, skipping = false, true
+		case strings.HasPrefix(strings.TrimSpace(line), "[") && strings.HasSuffix(strings.TrimSpace(line), "]"):
+			text := strings.TrimSpace(line)
+			name := strings.TrimSpace(text[1 : len(text)-1])
+			start := strings.Index(line, name)
+			currentSubsection = &model.Subsection{
+				Name:       name,
+				SourceLine: line,
+				Position:   pos,
+				Range:      tok.lineRange(),
+				NameRange:  subRange(tok.lineRange(), line, start, start+len(name)),
+			}
+			currentVersion.Subsections = append(currentVersion.Subsections, currentSubsection)
+			currentEntry = nil
+		case strings.HasPrefix(strings.TrimSpace(line), "* "):
+			if currentSubsection == nil { // entries outside any group
+				currentSubsection = unnamedSubsection(tok)
+				currentVersion.Subsections = append(currentVersion.Subsections, currentSubsection)
+			}
+			currentEntry = &model.Entry{Summary: strings.TrimSpace(line), Lines: []string{line}, Position: pos, Range: tok.lineRange()}
+			currentSubsection.History = append(currentSubsection.History, currentEntry)
+		case currentEntry != nil:
+			currentEntry.Summary += " " + strings.TrimSpace(line)
+			if gap {
+				currentEntry.Lines = append(currentEntry.Lines, "") // paragraph separator
+			}
+			currentEntry.Lines = append(currentEntry.Lines, line)
+			currentEntry.Range.End = tok.lineRange().End
+		default:
+			errs = append(errs, SyntaxError{
+				Message:  fmt.Sprintf("unexpected line: %s\nexpecting a change description like '  * description'", line),
+				Position: pos,
+				Range:    tok.lineRange(),
+			})
+		}
+	}
+	missingTrailer()
+
+	return partialResult(result, errs)
+}
+
+// version builds the version of a release heading
+func (Debian) version(tok token) (*model.Version, SyntaxErrors) {
+	line := tok.fullText
+	matches := debianHeadingPattern.FindStringSubmatchIndex(line)
+	if matches == nil {
+		return nil, SyntaxErrors{{
+			Message:  fmt.Sprintf("unexpected line: %s\nexpecting a release heading like 'package (version) distribution; urgency=medium'", line),
+			Position: tok.pos,
+			Range:    tok.lineRange(),
+		}}
+	}
+
+	result := &model.Version{
+		Version:    line[matches[4]:matches[5]],
+		SourceLine: line,
+		Position:   tok.pos,
+		Range:      tok.lineRange(),
+		NameRange:  subRange(tok.lineRange(), line, matches[4], matches[5]),
+		Metadata: map[string]model.Field{
+			"package":      lineField(tok, matches[2], matches[3]),
+			"distribution": lineField(tok, matches[6], matches[7]),
+		},
+	}
+
+	errs := SyntaxErrors{}
+	start := matches[8]
+	for _, option := range strings.Split(line[matches[8]:matches[9]], ",") {
+		optionStart := start
+		start += len(option) + 1
+		if strings.TrimSpace(option) == "" {
+			continue
+		}
+
+		keyValue := strings.SplitN(option, "=", 2)
+		if len(keyValue) != 2 {
+			errs = append(errs, SyntaxError{
+				Message:  fmt.Sprintf("malformed option %q of version %s, expecting key=value", strings.TrimSpace(option), result.Version),
+				Position: tok.pos,
+				Range:    subRange(tok.lineRange(), line, optionStart, optionStart+len(option)),
+			})
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(keyValue[0]))
+		value := strings.TrimSpace(keyValue[1])
+		valueStart := optionStart + len(keyValue[0]) + 1 + strings.Index(keyValue[1], value)
+		result.Metadata[key] = lineField(tok, valueStart, valueStart+len(value))
+	}
+
+	return result, errs
+}
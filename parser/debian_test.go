@@ -0,0 +1,89 @@
+package parser_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+func TestDebianParser(t *testing.T) {
+	input, err := os.Open("testdata/debian/changelog")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+	defer input.Close()
+
+	cl, err := parser.Debian{}.Parse(input, parserConf())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := []string{}
+	for _, v := range cl.Versions {
+		got = append(got, v.Version+" "+v.Metadata["distribution"].Value+" "+v.Metadata["urgency"].Value+" "+v.Date)
+		for _, s := range v.Subsections {
+			for _, e := range s.History {
+				got = append(got, "  ["+s.Name+"] "+e.Summary)
+			}
+		}
+	}
+	want := []string{
+		"0.4.0-1 unstable medium Sat, 19 Nov 2022 10:00:00 +0100",
+		"  [] * New upstream release.",
+		"  [] * Install the bash completion, requested by users.",
+		"  [Jane Doe] * Fix the watch file.",
+		"0.3.0-1 unstable low Fri, 4 Nov 2022 18:30:00 +0100",
+		"  [] * Initial release (Closes: #123456)",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+
+	version := cl.Versions[1]
+	if extent := version.Extent(); extent.Start.Line != 12 || extent.End.Line != 16 {
+		t.Errorf("expected version %s to span lines 12 to 16 (trailer included), got %+v", version.Version, extent)
+	}
+	if urgency := version.Metadata["urgency"]; urgency.Range.Start.Column != 44 || urgency.Range.End.Column != 47 {
+		t.Errorf("expected urgency %q to span columns 44 to 47, got %+v", urgency.Value, urgency.Range)
+	}
+}
+
+func TestDebianParserErrors(t *testing.T) {
+	source := `changelog-lint (0.4.0-1) unstable; urgency=medium
+
+  * New upstream release.
+
+changelog-lint 0.3.0-1 unstable; urgency=low
+
+  * Initial release.
+
+ -- John Doe <john@example.com>  Fri, 4 Nov 2022 18:30:00 +0100
+
+changelog-lint (0.2.0-1) unstable; urgency
+
+  [ Jane Doe ]
+continued
+ -- John Doe <john@example.com>  Fri, 4 Nov 2022 18:30:00 +0100
+`
+	cl, err := parser.Debian{}.Parse(strings.NewReader(source), parserConf())
+	errs, ok := err.(parser.SyntaxErrors)
+	if !ok {
+		t.Fatalf("expected syntax errors, got %v", err)
+	}
+
+	wantLines := []int{1, 5, 11, 11, 14}
+	if len(errs) != len(wantLines) {
+		t.Fatalf("expected %d errors, got %d:\n%v", len(wantLines), len(errs), errs)
+	}
+	for i, e := range errs {
+		if e.Position != wantLines[i] {
+			t.Errorf("expected error %d at line %d, got %v", i, wantLines[i], e)
+		}
+	}
+
+	if len(cl.Versions) != 2 {
+		t.Fatalf("expected the parseable versions to be kept, got %d versions", len(cl.Versions))
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/chavacava/changelog-lint/model"
 )
@@ -14,6 +17,11 @@ import (
 // Parses Markdown changelogs
 type Default struct{}
 
+// Name yields the name of the format of the changelogs handled by the parser
+func (Default) Name() string {
+	return "markdown"
+}
+
 type tokenKind int
 
 const (
@@ -23,6 +31,8 @@ const (
 	kindSubsection
 	kindEntry
 	kindPlain
+	kindDirective
+	kindLink
 	kindEOF
 	kindError
 )
@@ -36,12 +46,116 @@ const (
 	subsection
 	entry
 	entryContinuation
+	done
 )
 
 type token struct {
 	fullText string
 	kind     tokenKind
 	pos      int
+	offset   int // byte offset of the line in the source
+}
+
+// lineRange yields the source range of the line of the token
+func (t token) lineRange() model.Range {
+	return model.Range{
+		Start: model.Location{Line: t.pos, Column: 1, Offset: t.offset},
+		End:   model.Location{Line: t.pos, Column: utf8.RuneCountInString(t.fullText) + 1, Offset: t.offset + len(t.fullText)},
+	}
+}
+
+// lineScanner scans the lines of a source keeping track of their byte offsets
+type lineScanner struct {
+	*bufio.Scanner
+	offset int // byte offset of the last scanned line
+	next   int // byte offset following the last scanned line
+}
+
+func newLineScanner(r io.Reader) *lineScanner {
+	result := &lineScanner{Scanner: bufio.NewScanner(r)}
+	result.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, line, err := bufio.ScanLines(data, atEOF)
+		if line != nil {
+			result.offset = result.next
+			result.next += advance
+		}
+		return advance, line, err
+	})
+
+	return result
+}
+
+// subRange yields the source range of text[start:end] where text is the source line spanning lineRange
+func subRange(lineRange model.Range, text string, start, end int) model.Range {
+	return model.Range{
+		Start: model.Location{
+			Line:   lineRange.Start.Line,
+			Column: lineRange.Start.Column + utf8.RuneCountInString(text[:start]),
+			Offset: lineRange.Start.Offset + start,
+		},
+		End: model.Location{
+			Line:   lineRange.Start.Line,
+			Column: lineRange.Start.Column + utf8.RuneCountInString(text[:end]),
+			Offset: lineRange.Start.Offset + end,
+		},
+	}
+}
+
+// directivePattern matches linter directives like <!-- changelog-lint-disable version-order, version-empty -->
+var directivePattern = regexp.MustCompile(`^<!--\s*changelog-lint-(disable-next-line|disable|enable)((?:[\s,]+[\w-]+)*)[\s,]*-->$`)
+
+// linkPattern matches link reference definitions like [1.0.0]: https://github.com/owner/repo/compare/v0.9.0...v1.0.0 "title"
+var linkPattern = regexp.MustCompile(`^ {0,3}\[([^\]]+)\]:[ \t]*(\S+)(?:[ \t]+(\S.*?))?[ \t]*$`)
+
+// Names of the groups of patterns with a specific meaning, the values of other named groups are metadata of the nodes
+const (
+	groupVersion    = "version"    // name of the version, the first group if the version pattern has no group of this name
+	groupDate       = "date"       // release date of the version
+	groupSubsection = "subsection" // name of the subsection, the first group if the subsection pattern has no group of this name
+)
+
+// nameGroup yields the index of the group of the pattern capturing the name of the node: the group of the given name or the first one
+func nameGroup(pattern *regexp.Regexp, name string) int {
+	if i := pattern.SubexpIndex(name); i > 0 {
+		return i
+	}
+
+	return 1
+}
+
+// namedFields yields the values of the named groups of the pattern, but the excluded ones, captured in the text by matches.
+// The text starts with the source line spanning lineRange; values beyond that line have no source range.
+// It returns nil if no named group captured a value.
+func namedFields(pattern *regexp.Regexp, matches []int, text string, lineRange model.Range, excluded ...string) map[string]model.Field {
+	var result map[string]model.Field
+	for i, name := range pattern.SubexpNames() {
+		start, end := matches[2*i], matches[2*i+1]
+		if name == "" || start < 0 || contains(excluded, name) {
+			continue
+		}
+
+		field := model.Field{Value: text[start:end]}
+		if end <= lineRange.End.Offset-lineRange.Start.Offset {
+			field.Range = subRange(lineRange, text, start, end)
+		}
+		if result == nil {
+			result = map[string]model.Field{}
+		}
+		result[name] = field
+	}
+
+	return result
+}
+
+// contains returns true if the list contains the string
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
 }
 
 type decoratorConfig struct {
@@ -49,35 +163,46 @@ type decoratorConfig struct {
 	versionPattern    *regexp.Regexp
 	subsectionPattern *regexp.Regexp
 	entryPattern      *regexp.Regexp
+	dateLayout        string
 }
 
-// Parse parses a changelog
+// Parse parses a changelog.
+// Syntax errors do not stop the parsing: all of them are returned as SyntaxErrors
+// together with the parts of the changelog that could be parsed.
 func (p Default) Parse(r io.Reader, config *Config) (*model.Changelog, error) {
-	cl, err := p.parse(r)
-	if err != nil {
-		return nil, err
+	cl, errs := p.parse(r)
+	errs = append(errs, p.decorateChangelog(cl, p.extractDecoratorConfig(config))...)
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Position < errs[j].Position })
+		return cl, errs
 	}
 
-	err = p.decorateChangelog(cl, p.extractDecoratorConfig(config))
-
-	return cl, err
+	return cl, nil
 }
 
-func (p Default) decorateChangelog(cl *model.Changelog, config decoratorConfig) error {
-	if !config.titlePattern.Match([]byte(cl.Header[0])) {
-		return p.normalizeError(
-			fmt.Sprintf("the title\n\t%s\ndoes not match %s", cl.Header[0], config.titlePattern.String()),
-			1,
-		)
+func (p Default) decorateChangelog(cl *model.Changelog, config decoratorConfig) SyntaxErrors {
+	if config.versionPattern.SubexpIndex(groupDate) > 0 {
+		cl.DateLayout = config.dateLayout // versions have no date if the pattern does not capture them
+	}
+	errs := SyntaxErrors{}
+	if len(cl.Header) > 0 && !config.titlePattern.MatchString(cl.Header[0]) {
+		errs = append(errs, SyntaxError{
+			Message:  fmt.Sprintf("the title\n\t%s\ndoes not match %s", cl.Header[0], config.titlePattern.String()),
+			Position: 1,
+		})
 	}
+
+	versions := []*model.Version{}
 	for _, v := range cl.Versions {
-		err := p.decorateVersion(v, config)
-		if err != nil {
-			return err
+		ok, vErrs := p.decorateVersion(v, config)
+		errs = append(errs, vErrs...)
+		if ok {
+			versions = append(versions, v)
 		}
 	}
+	cl.Versions = versions
 
-	return nil
+	return errs
 }
 
 func (p Default) extractDecoratorConfig(conf *Config) decoratorConfig {
@@ -86,65 +211,103 @@ func (p Default) extractDecoratorConfig(conf *Config) decoratorConfig {
 		versionPattern:    conf.VersionPattern,
 		subsectionPattern: conf.SubsectionPattern,
 		entryPattern:      conf.EntryPattern,
+		dateLayout:        conf.dateLayout(),
 	}
 }
 
-func (p Default) decorateVersion(v *model.Version, config decoratorConfig) error {
-	matches := config.versionPattern.FindStringSubmatch(v.SourceLine)
-	if len(matches) < 2 {
-		return p.normalizeError(
-			fmt.Sprintf("the version\n\t%s\ndoes not match %s", v.SourceLine, config.versionPattern.String()),
-			v.Position,
-		)
+// decorateVersion returns false if the version does not match the version pattern.
+// Badly formatted subsections are removed from the version.
+func (p Default) decorateVersion(v *model.Version, config decoratorConfig) (bool, SyntaxErrors) {
+	matches := config.versionPattern.FindStringSubmatchIndex(v.SourceLine)
+	name := nameGroup(config.versionPattern, groupVersion)
+	if len(matches) < 2*name+2 || matches[2*name] < 0 {
+		return false, SyntaxErrors{{
+			Message:  fmt.Sprintf("the version\n\t%s\ndoes not match %s", v.SourceLine, config.versionPattern.String()),
+			Position: v.Position,
+			Range:    v.Range,
+		}}
 	}
 
-	v.Version = matches[1]
+	v.Version = v.SourceLine[matches[2*name]:matches[2*name+1]]
+	v.NameRange = subRange(v.Range, v.SourceLine, matches[2*name], matches[2*name+1])
+	v.Metadata = namedFields(config.versionPattern, matches, v.SourceLine, v.Range, groupVersion)
+	if date, ok := v.Metadata[groupDate]; ok {
+		v.Date, v.DateRange = date.Value, date.Range
+		delete(v.Metadata, groupDate)
+	}
+	if len(v.Metadata) == 0 {
+		v.Metadata = nil
+	}
 
+	errs := SyntaxErrors{}
+	subsections := []*model.Subsection{}
 	for _, s := range v.Subsections {
-		err := p.decorateSubsection(s, config)
-		if err != nil {
-			return fmt.Errorf("version %q contains a bad formated subsection: %v", v.Version, err)
+		ok, sErrs := p.decorateSubsection(s, config)
+		for _, err := range sErrs {
+			err.Message = fmt.Sprintf("version %q contains a bad formated subsection: %s", v.Version, err.Message)
+			errs = append(errs, err)
+		}
+		if ok {
+			subsections = append(subsections, s)
 		}
 	}
+	v.Subsections = subsections
 
-	return nil
+	return true, errs
 }
 
-func (p Default) decorateSubsection(s *model.Subsection, config decoratorConfig) error {
-	matches := config.subsectionPattern.FindStringSubmatch(s.SourceLine)
-	if len(matches) < 2 {
-		return p.normalizeError(
-			fmt.Sprintf("the subsection\n\t%s\ndoes not match %s", s.SourceLine, config.subsectionPattern.String()),
-			s.Position,
-		)
+// decorateSubsection returns false if the subsection does not match the subsection pattern.
+// Badly formatted entries are removed from the subsection.
+func (p Default) decorateSubsection(s *model.Subsection, config decoratorConfig) (bool, SyntaxErrors) {
+	matches := config.subsectionPattern.FindStringSubmatchIndex(s.SourceLine)
+	name := nameGroup(config.subsectionPattern, groupSubsection)
+	if len(matches) < 2*name+2 || matches[2*name] < 0 {
+		return false, SyntaxErrors{{
+			Message:  fmt.Sprintf("the subsection\n\t%s\ndoes not match %s", s.SourceLine, config.subsectionPattern.String()),
+			Position: s.Position,
+			Range:    s.Range,
+		}}
 	}
-	s.Name = matches[1]
+	s.Name = s.SourceLine[matches[2*name]:matches[2*name+1]]
+	s.NameRange = subRange(s.Range, s.SourceLine, matches[2*name], matches[2*name+1])
+	s.Metadata = namedFields(config.subsectionPattern, matches, s.SourceLine, s.Range, groupSubsection)
 
+	errs := SyntaxErrors{}
+	history := []*model.Entry{}
 	for _, e := range s.History {
 		err := p.decorateEntry(e, config)
 		if err != nil {
-			return fmt.Errorf("subsection %q contains a bad formated entry: %v", s.Name, err)
+			err.Message = fmt.Sprintf("subsection %q contains a bad formated entry: %s", s.Name, err.Message)
+			errs = append(errs, *err)
+			continue
 		}
+		history = append(history, e)
 	}
+	s.History = history
 
-	return nil
+	return true, errs
 }
 
-func (p Default) decorateEntry(e *model.Entry, config decoratorConfig) error {
-	matches := config.entryPattern.FindStringSubmatch(e.Summary)
-	if len(matches) < 1 {
-		return p.normalizeError(
-			fmt.Sprintf("the entry\n\t%s\ndoes not match %s", e.Summary, config.entryPattern.String()),
-			e.Position)
+func (p Default) decorateEntry(e *model.Entry, config decoratorConfig) *SyntaxError {
+	matches := config.entryPattern.FindStringSubmatchIndex(e.Summary)
+	if len(matches) < 2 {
+		return &SyntaxError{
+			Message:  fmt.Sprintf("the entry\n\t%s\ndoes not match %s", e.Summary, config.entryPattern.String()),
+			Position: e.Position,
+			Range:    e.Range,
+		}
 	}
+	firstLine := subRange(e.Range, e.Lines[0], 0, len(e.Lines[0])) // the summary starts with the first line of the entry
+	e.Metadata = namedFields(config.entryPattern, matches, e.Summary, firstLine)
 
 	return nil
 }
 
-func (p Default) parse(r io.Reader) (*model.Changelog, error) {
+func (p Default) parse(r io.Reader) (*model.Changelog, SyntaxErrors) {
 	result := model.NewChangelog()
-	scanner := bufio.NewScanner(r)
-	scanner.Split(bufio.ScanLines)
+	result.Format = p.Name()
+	errs := SyntaxErrors{}
+	scanner := newLineScanner(r)
 
 	tokens := make(chan token)
 	go func() {
@@ -156,17 +319,41 @@ func (p Default) parse(r io.Reader) (*model.Changelog, error) {
 			if lineKind == kindEmpty {
 				continue
 			}
-			tokens <- token{fullText: line, kind: lineKind, pos: pos}
+			tokens <- token{fullText: line, kind: lineKind, pos: pos, offset: scanner.offset}
 		}
-		tokens <- token{fullText: "", kind: kindEOF, pos: pos + 1}
+		tokens <- token{fullText: "", kind: kindEOF, pos: pos + 1, offset: scanner.next}
 		close(tokens)
 	}()
 
+	previousLine, currentLine := 0, 0 // line numbers of the last two non-empty lines
+	// next yields the next token, directives and link reference definitions are recorded and skipped
+	// because they can appear anywhere in the changelog
+	next := func() token {
+		for {
+			tok := <-tokens
+			previousLine, currentLine = currentLine, tok.pos
+			switch tok.kind {
+			case kindDirective:
+				result.Directives = append(result.Directives, p.directive(tok))
+			case kindLink:
+				result.Links = append(result.Links, p.link(tok))
+			default:
+				return tok
+			}
+		}
+	}
+
 	state := initial
-	tok := <-tokens
+	tok := next()
 	var currentVersion *model.Version
 	var currentSubsection *model.Subsection
 	var currentEntry *model.Entry
+	// unexpected records a syntax error on the current token and
+	// moves the parser to the next point from where it can resume
+	unexpected := func(msg string) {
+		errs = append(errs, SyntaxError{Message: msg, Position: tok.pos, Range: tok.lineRange()})
+		tok, state = p.resync(tok, next, currentVersion != nil)
+	}
 	for {
 		switch state {
 		case initial:
@@ -174,52 +361,49 @@ func (p Default) parse(r io.Reader) (*model.Changelog, error) {
 			case kindTitle:
 				state = title
 			case kindEOF:
-				return nil, p.normalizeError("unexpected end of file", tok.pos)
+				errs = append(errs, SyntaxError{Message: "unexpected end of file", Position: tok.pos, Range: tok.lineRange()})
+				return result, errs
 			default:
-				return nil, p.normalizeError(
-					fmt.Sprintf("unexpected line: %s\nexpecting empty line or main title", tok.fullText),
-					tok.pos,
-				)
+				unexpected(fmt.Sprintf("unexpected line: %s\nexpecting empty line or main title", tok.fullText))
 			}
 		case title:
+			if len(result.Header) > 0 && tok.pos > previousLine+1 {
+				result.Header = append(result.Header, "") // paragraph separator
+			}
 			result.Header = append(result.Header, tok.fullText)
-			tok = <-tokens
+			tok = next()
 			switch tok.kind {
 			case kindPlain:
 				// do nothing -> it will return to case title
 			case kindVersion:
 				state = version
 			case kindEOF:
-				return nil, p.normalizeError("unexpected end of file", tok.pos)
+				errs = append(errs, SyntaxError{Message: "unexpected end of file", Position: tok.pos, Range: tok.lineRange()})
+				return result, errs
 			default:
-				return nil, p.normalizeError(
-					fmt.Sprintf("unexpected line: %s\nexpecting plain text line or version", tok.fullText),
-					tok.pos,
-				)
+				unexpected(fmt.Sprintf("unexpected line: %s\nexpecting plain text line or version", tok.fullText))
 			}
 		case version:
-			newVersion := &model.Version{SourceLine: tok.fullText, Position: tok.pos}
+			newVersion := &model.Version{SourceLine: tok.fullText, Position: tok.pos, Range: tok.lineRange()}
 			result.Versions = append(result.Versions, newVersion)
 			currentVersion = newVersion
-			tok = <-tokens
+			tok = next()
 			switch tok.kind {
 			case kindVersion:
 				state = version
 			case kindSubsection:
 				state = subsection
 			case kindEOF:
-				return nil, p.normalizeError("unexpected end of file", tok.pos)
+				errs = append(errs, SyntaxError{Message: "unexpected end of file", Position: tok.pos, Range: tok.lineRange()})
+				return result, errs
 			default:
-				return nil, p.normalizeError(
-					fmt.Sprintf("unexpected line:%s\nexpecting subsection or version", tok.fullText),
-					tok.pos,
-				)
+				unexpected(fmt.Sprintf("unexpected line:%s\nexpecting subsection or version", tok.fullText))
 			}
 		case subsection:
-			newSubsection := &model.Subsection{SourceLine: tok.fullText, Position: tok.pos}
+			newSubsection := &model.Subsection{SourceLine: tok.fullText, Position: tok.pos, Range: tok.lineRange()}
 			currentVersion.Subsections = append(currentVersion.Subsections, newSubsection)
 			currentSubsection = newSubsection
-			tok = <-tokens
+			tok = next()
 			switch tok.kind {
 			case kindSubsection:
 				state = subsection
@@ -228,18 +412,16 @@ func (p Default) parse(r io.Reader) (*model.Changelog, error) {
 			case kindEntry:
 				state = entry
 			case kindEOF:
-				return nil, p.normalizeError("unexpected end of file", tok.pos)
+				errs = append(errs, SyntaxError{Message: "unexpected end of file", Position: tok.pos, Range: tok.lineRange()})
+				return result, errs
 			default:
-				return nil, p.normalizeError(
-					fmt.Sprintf("unexpected line:%s\nexpecting subsection, version or change description", tok.fullText),
-					tok.pos,
-				)
+				unexpected(fmt.Sprintf("unexpected line:%s\nexpecting subsection, version or change description", tok.fullText))
 			}
 		case entry:
-			newEntry := &model.Entry{Summary: tok.fullText, Position: tok.pos}
+			newEntry := &model.Entry{Summary: tok.fullText, Lines: []string{tok.fullText}, Position: tok.pos, Range: tok.lineRange()}
 			currentSubsection.History = append(currentSubsection.History, newEntry)
 			currentEntry = newEntry
-			tok = <-tokens
+			tok = next()
 			switch tok.kind {
 			case kindSubsection:
 				state = subsection
@@ -248,18 +430,20 @@ func (p Default) parse(r io.Reader) (*model.Changelog, error) {
 			case kindEntry:
 				state = entry
 			case kindEOF:
-				return result, nil
+				return result, errs
 			case kindPlain:
 				state = entryContinuation
 			default:
-				return nil, p.normalizeError(
-					fmt.Sprintf("unexpected line:%s\nexpecting subsection, version or change description", tok.fullText),
-					tok.pos,
-				)
+				unexpected(fmt.Sprintf("unexpected line:%s\nexpecting subsection, version or change description", tok.fullText))
 			}
 		case entryContinuation:
 			currentEntry.Summary += " " + tok.fullText
-			tok = <-tokens
+			if tok.pos > previousLine+1 {
+				currentEntry.Lines = append(currentEntry.Lines, "") // paragraph separator
+			}
+			currentEntry.Lines = append(currentEntry.Lines, tok.fullText)
+			currentEntry.Range.End = tok.lineRange().End
+			tok = next()
 			switch tok.kind {
 			case kindSubsection:
 				state = subsection
@@ -270,19 +454,67 @@ func (p Default) parse(r io.Reader) (*model.Changelog, error) {
 			case kindPlain:
 				state = entryContinuation
 			case kindEOF:
-				return result, nil
+				return result, errs
 			default:
-				return nil, p.normalizeError(
-					fmt.Sprintf("unexpected line:%s\nexpecting subsection, version or change description", tok.fullText),
-					tok.pos,
-				)
+				unexpected(fmt.Sprintf("unexpected line:%s\nexpecting subsection, version or change description", tok.fullText))
 			}
+		case done:
+			return result, errs
 		}
 	}
 }
 
+// resync skips tokens, starting from the given one, until finding one from where the parsing can resume:
+// a version, a subsection (only if inVersion) or the end of file
+func (Default) resync(tok token, next func() token, inVersion bool) (token, state) {
+	for {
+		switch {
+		case tok.kind == kindVersion:
+			return tok, version
+		case tok.kind == kindSubsection && inVersion:
+			return tok, subsection
+		case tok.kind == kindEOF:
+			return tok, done
+		}
+		tok = next()
+	}
+}
+
+// directive builds the directive of the given directive token
+func (Default) directive(tok token) *model.Directive {
+	matches := directivePattern.FindStringSubmatch(strings.TrimSpace(tok.fullText))
+	rules := strings.FieldsFunc(matches[2], func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+
+	return &model.Directive{Kind: matches[1], Rules: rules, Position: tok.pos, Range: tok.lineRange()}
+}
+
+// link builds the link reference definition of the given link token
+func (Default) link(tok token) *model.Link {
+	matches := linkPattern.FindStringSubmatchIndex(tok.fullText)
+	result := &model.Link{
+		Label:    tok.fullText[matches[2]:matches[3]],
+		URL:      tok.fullText[matches[4]:matches[5]],
+		Position: tok.pos,
+		Range:    tok.lineRange(),
+		URLRange: subRange(tok.lineRange(), tok.fullText, matches[4], matches[5]),
+	}
+	if matches[6] >= 0 {
+		result.Title = tok.fullText[matches[6]:matches[7]]
+	}
+
+	return result
+}
+
 func (Default) retrieveLineKind(line string) tokenKind {
 	trimedLine := strings.Trim(line, " ")
+	if directivePattern.MatchString(strings.TrimSpace(line)) {
+		return kindDirective
+	}
+
+	if linkPattern.MatchString(line) {
+		return kindLink
+	}
+
 	if strings.HasPrefix(trimedLine, "###") {
 		return kindSubsection
 	}
@@ -305,7 +537,3 @@ func (Default) retrieveLineKind(line string) tokenKind {
 
 	return kindPlain
 }
-
-func (Default) normalizeError(msg string, pos int) error {
-	return fmt.Errorf("%s (line %d)", msg, pos)
-}
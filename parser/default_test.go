@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/chavacava/changelog-lint/model"
 	"github.com/chavacava/changelog-lint/parser"
 )
 
@@ -41,7 +42,7 @@ expecting subsection, version or change description`,
 		},
 		{
 			data: "CHANGELOG_ERR_6.md",
-			err:  "the version\n\t## 19.0\n" + `does not match ^## \[?(\d+\.\d+.\d+|Unreleased)\]?( .*)*$ (line 7)`,
+			err:  "the version\n\t## 19.0\n" + `does not match ^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$ (line 7)`,
 		},
 		{
 			data: "CHANGELOG_ERR_7.md",
@@ -80,8 +81,222 @@ expecting subsection, version or change description`,
 func parserConf() *parser.Config {
 	return &parser.Config{
 		TitlePattern:      regexp.MustCompile(`.+`),
-		VersionPattern:    regexp.MustCompile(`^## \[?(\d+\.\d+.\d+|Unreleased)\]?( .*)*$`),
+		VersionPattern:    regexp.MustCompile(`^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$`),
 		SubsectionPattern: regexp.MustCompile(`^### ([A-Z]+[a-z]+)[ ]*$`),
 		EntryPattern:      regexp.MustCompile(`^[*-] .+$`),
 	}
 }
+
+func TestDefaultParserRecovery(t *testing.T) {
+	file, err := os.Open("testdata/CHANGELOG_ERR_8.md")
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+
+	p := parser.Default{}
+	cl, err := p.Parse(file, parserConf())
+
+	syntaxErrors, ok := err.(parser.SyntaxErrors)
+	if !ok {
+		t.Fatalf("expected syntax errors, got %v", err)
+	}
+
+	wantPositions := []int{9, 14, 19}
+	if len(syntaxErrors) != len(wantPositions) {
+		t.Fatalf("expected %d syntax errors, got %d:\n%v", len(wantPositions), len(syntaxErrors), syntaxErrors)
+	}
+	for i, want := range wantPositions {
+		if got := syntaxErrors[i].Position; got != want {
+			t.Fatalf("expected syntax error %d at line %d, got line %d", i, want, got)
+		}
+	}
+
+	if cl == nil {
+		t.Fatal("expected a partial changelog, got nil")
+	}
+
+	wantVersions := map[string][]string{"1.2.0": {"Added", "Fixed"}, "1.0.0": {"Changed"}}
+	if len(cl.Versions) != len(wantVersions) {
+		t.Fatalf("expected %d versions in the partial changelog, got %d", len(wantVersions), len(cl.Versions))
+	}
+	for _, v := range cl.Versions {
+		wantSubsections, ok := wantVersions[v.Version]
+		if !ok {
+			t.Fatalf("unexpected version %q in the partial changelog", v.Version)
+		}
+		got := []string{}
+		for _, s := range v.Subsections {
+			got = append(got, s.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(wantSubsections, ",") {
+			t.Fatalf("expected subsections %v in version %s, got %v", wantSubsections, v.Version, got)
+		}
+	}
+}
+
+func TestDefaultParserRanges(t *testing.T) {
+	source := "# Changelog\r\n\r\n## [1.0.0] - 2020-01-01\r\n### Added\r\n- héllo\r\n  bé\r\n"
+
+	p := parser.Default{}
+	cl, err := p.Parse(strings.NewReader(source), parserConf())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	version := cl.Versions[0]
+	subsection := version.Subsections[0]
+	entry := subsection.History[0]
+	testCases := []struct {
+		node string
+		got  model.Range
+		want model.Range
+	}{
+		{"version", version.Range, model.Range{Start: model.Location{Line: 3, Column: 1, Offset: 15}, End: model.Location{Line: 3, Column: 24, Offset: 38}}},
+		{"version name", version.NameRange, model.Range{Start: model.Location{Line: 3, Column: 5, Offset: 19}, End: model.Location{Line: 3, Column: 10, Offset: 24}}},
+		{"version date", version.DateRange, model.Range{Start: model.Location{Line: 3, Column: 14, Offset: 28}, End: model.Location{Line: 3, Column: 24, Offset: 38}}},
+		{"subsection", subsection.Range, model.Range{Start: model.Location{Line: 4, Column: 1, Offset: 40}, End: model.Location{Line: 4, Column: 10, Offset: 49}}},
+		{"subsection name", subsection.NameRange, model.Range{Start: model.Location{Line: 4, Column: 5, Offset: 44}, End: model.Location{Line: 4, Column: 10, Offset: 49}}},
+		{"entry", entry.Range, model.Range{Start: model.Location{Line: 5, Column: 1, Offset: 51}, End: model.Location{Line: 6, Column: 5, Offset: 66}}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("expected %s range to be %+v, got %+v", tc.node, tc.want, tc.got)
+		}
+	}
+
+	if name := source[version.NameRange.Start.Offset:version.NameRange.End.Offset]; name != version.Version {
+		t.Errorf("expected version name range to cover %q, got %q", version.Version, name)
+	}
+	if version.Date != "2020-01-01" || cl.DateLayout != "2006-01-02" {
+		t.Errorf("expected version date 2020-01-01 in the 2006-01-02 layout, got %q in the %q layout", version.Date, cl.DateLayout)
+	}
+}
+
+func TestDefaultParserDirectives(t *testing.T) {
+	source := `<!-- changelog-lint-disable -->
+# Changelog
+## 1.1.0
+<!-- changelog-lint-disable-next-line subsection-naming, subsection-empty -->
+### Added
+- one
+<!--changelog-lint-enable version-order-->
+  continued
+<!-- changelog-lint-disabled -->
+`
+
+	p := parser.Default{}
+	cl, err := p.Parse(strings.NewReader(source), parserConf())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []model.Directive{
+		{Kind: model.DirectiveDisable, Position: 1},
+		{Kind: model.DirectiveDisableNextLine, Rules: []string{"subsection-naming", "subsection-empty"}, Position: 4},
+		{Kind: model.DirectiveEnable, Rules: []string{"version-order"}, Position: 7},
+	}
+	if len(cl.Directives) != len(want) {
+		t.Fatalf("expected %d directives, got %d", len(want), len(cl.Directives))
+	}
+	for i, d := range cl.Directives {
+		if d.Kind != want[i].Kind || d.Position != want[i].Position || strings.Join(d.Rules, ",") != strings.Join(want[i].Rules, ",") {
+			t.Errorf("expected directive %+v, got %+v", want[i], *d)
+		}
+	}
+
+	entry := cl.Versions[0].Subsections[0].History[0]
+	wantLines := []string{"- one", "  continued", "<!-- changelog-lint-disabled -->"}
+	if strings.Join(entry.Lines, "\n") != strings.Join(wantLines, "\n") {
+		t.Errorf("expected entry lines %q, got %q", wantLines, entry.Lines)
+	}
+}
+
+func TestDefaultParserNamedGroups(t *testing.T) {
+	source := "# Changelog\n\n## [1.0.0](https://example.com/compare/v0.9.0...v1.0.0) (2022-11-19) [LTS]\n### ⚠ Added\n- feature (#12)\n  continued (@jane)\n"
+	config := &parser.Config{
+		TitlePattern:      regexp.MustCompile(`.+`),
+		VersionPattern:    regexp.MustCompile(`^## \[(?P<version>[^\]]+)\]\((?P<link>[^)]+)\) \((?P<date>[^)]+)\)(?: \[(?P<label>\w+)\])?$`),
+		SubsectionPattern: regexp.MustCompile(`^### (?P<flag>⚠ )?(?P<subsection>\w+)$`),
+		EntryPattern:      regexp.MustCompile(`^- [^(]*\(#(?P<issue>\d+)\)[^(]*\(@(?P<author>\w+)\)$`),
+	}
+
+	cl, err := parser.Default{}.Parse(strings.NewReader(source), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	version := cl.Versions[0]
+	subsection := version.Subsections[0]
+	entry := subsection.History[0]
+	testCases := []struct {
+		node string
+		got  string
+		want string
+	}{
+		{"version", version.Version, "1.0.0"},
+		{"version date", version.Date, "2022-11-19"},
+		{"version link", version.Metadata["link"].Value, "https://example.com/compare/v0.9.0...v1.0.0"},
+		{"version label", version.Metadata["label"].Value, "LTS"},
+		{"subsection", subsection.Name, "Added"},
+		{"subsection flag", subsection.Metadata["flag"].Value, "⚠ "},
+		{"entry issue", entry.Metadata["issue"].Value, "12"},
+		{"entry author", entry.Metadata["author"].Value, "jane"},
+	}
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("expected %s to be %q, got %q", tc.node, tc.want, tc.got)
+		}
+	}
+
+	if _, ok := version.Metadata["version"]; ok {
+		t.Errorf("expected reserved groups to be out of the metadata, got %v", version.Metadata)
+	}
+	if link := version.Metadata["link"].Range; source[link.Start.Offset:link.End.Offset] != version.Metadata["link"].Value {
+		t.Errorf("expected link range to cover the link, got %+v", link)
+	}
+	if issue := entry.Metadata["issue"].Range; source[issue.Start.Offset:issue.End.Offset] != "12" {
+		t.Errorf("expected issue range to cover the issue, got %+v", issue)
+	}
+	if author := entry.Metadata["author"].Range; author != (model.Range{}) {
+		t.Errorf("expected no range for values beyond the first line of entries, got %+v", author)
+	}
+}
+
+func TestDefaultParserLinks(t *testing.T) {
+	source := "# Changelog\n\n## [1.0.0] - 2022-11-19\n### Added\n- one\n\n[1.0.0]: https://example.com/compare/v0.9.0...v1.0.0\n   [Other]:  https://example.com \"Title\"\n"
+
+	cl, err := parser.Default{}.Parse(strings.NewReader(source), parserConf())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []model.Link{
+		{Label: "1.0.0", URL: "https://example.com/compare/v0.9.0...v1.0.0", Position: 7},
+		{Label: "Other", URL: "https://example.com", Title: `"Title"`, Position: 8},
+	}
+	if len(cl.Links) != len(want) {
+		t.Fatalf("expected %d links, got %d", len(want), len(cl.Links))
+	}
+	for i, l := range cl.Links {
+		if l.Label != want[i].Label || l.URL != want[i].URL || l.Title != want[i].Title || l.Position != want[i].Position {
+			t.Errorf("expected link %+v, got %+v", want[i], *l)
+		}
+		if url := source[l.URLRange.Start.Offset:l.URLRange.End.Offset]; url != l.URL {
+			t.Errorf("expected URL range to cover %q, got %q", l.URL, url)
+		}
+	}
+
+	entry := cl.Versions[0].Subsections[0].History[0]
+	if len(entry.Lines) != 1 {
+		t.Errorf("expected link reference definitions out of entries, got entry lines %q", entry.Lines)
+	}
+
+	base, from, to, ok := cl.Links[0].Compare()
+	if !ok || base != "https://example.com/compare/" || from != "v0.9.0" || to != "v1.0.0" {
+		t.Errorf("unexpected compare URL parts %q, %q, %q (%v)", base, from, to, ok)
+	}
+	if _, _, _, ok := cl.Links[1].Compare(); ok {
+		t.Errorf("expected %s not to be a compare URL", cl.Links[1].URL)
+	}
+}
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// SyntaxError is a positioned error found while parsing a changelog
+type SyntaxError struct {
+	Message  string
+	Position int         // line number in the changelog
+	Range    model.Range // source range of the error
+}
+
+func (e SyntaxError) Error() string {
+	if e.Position <= 0 {
+		return e.Message // the error is not related to a line
+	}
+
+	return fmt.Sprintf("%s (line %d)", e.Message, e.Position)
+}
+
+// SyntaxErrors gathers all the syntax errors found while parsing a changelog
+type SyntaxErrors []SyntaxError
+
+func (e SyntaxErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
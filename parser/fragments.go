@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// Fragments parses YAML change fragments, as written by tools like changie,
+// into a changelog with a single Unreleased version.
+// The kind of a fragment is the name of its subsection, its body is the entry,
+// and entries are sorted by the time of their fragments.
+// Fragments are not positioned in a single file, thus nodes of the changelog have no position.
+type Fragments struct{}
+
+// fragment is a change fragment
+type fragment struct {
+	Kind string    `yaml:"kind"`
+	Body string    `yaml:"body"`
+	Time time.Time `yaml:"time"`
+}
+
+// fragmentExtensions are the extensions of fragment files
+var fragmentExtensions = map[string]bool{".yaml": true, ".yml": true}
+
+// Name yields the name of the format of the changelogs handled by the parser
+func (Fragments) Name() string {
+	return "fragments"
+}
+
+// Parse parses a stream of fragments separated by YAML document markers (---)
+func (p Fragments) Parse(r io.Reader, _ *Config) (*model.Changelog, error) {
+	fragments, errs := p.decode(r, "stream")
+	return p.changelog(fragments, errs)
+}
+
+// ParsePath parses the fragment files (*.yaml and *.yml) of a directory, or a single fragment file
+func (p Fragments) ParsePath(path string, _ *Config) (*model.Changelog, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		files = []string{}
+		for _, e := range entries {
+			if e.IsDir() || !fragmentExtensions[filepath.Ext(e.Name())] {
+				continue
+			}
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+	}
+
+	fragments := []fragment{}
+	errs := SyntaxErrors{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		fFragments, fErrs := p.decode(f, file)
+		f.Close()
+		fragments = append(fragments, fFragments...)
+		errs = append(errs, fErrs...)
+	}
+
+	return p.changelog(fragments, errs)
+}
+
+// decode yields the fragments of a YAML stream, the name identifies the stream in syntax errors
+func (Fragments) decode(r io.Reader, name string) ([]fragment, SyntaxErrors) {
+	result := []fragment{}
+	errs := SyntaxErrors{}
+	decoder := yaml.NewDecoder(r)
+	for i := 1; ; i++ {
+		f := fragment{}
+		err := decoder.Decode(&f)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			errs = append(errs, SyntaxError{Message: fmt.Sprintf("bad fragment in %s: %v", name, err)})
+			break // the decoder can not resume after an error
+		}
+		if strings.TrimSpace(f.Kind) == "" {
+			errs = append(errs, SyntaxError{Message: fmt.Sprintf("fragment %d in %s has no kind", i, name)})
+			continue
+		}
+		result = append(result, f)
+	}
+
+	return result, errs
+}
+
+// changelog builds the changelog of the given fragments
+func (p Fragments) changelog(fragments []fragment, errs SyntaxErrors) (*model.Changelog, error) {
+	result := model.NewChangelog()
+	result.Format = p.Name()
+	if len(fragments) > 0 {
+		sort.SliceStable(fragments, func(i, j int) bool { return fragments[i].Time.Before(fragments[j].Time) })
+
+		version := &model.Version{Version: "Unreleased", SourceLine: "## Unreleased"}
+		subsections := map[string]*model.Subsection{}
+		for _, f := range fragments {
+			kind := strings.TrimSpace(f.Kind)
+			subsection, ok := subsections[kind]
+			if !ok {
+				subsection = &model.Subsection{Name: kind, SourceLine: "### " + kind}
+				subsections[kind] = subsection
+				version.Subsections = append(version.Subsections, subsection)
+			}
+
+			// fragments without body still create their subsection to let rules report it as empty
+			body := strings.TrimSpace(f.Body)
+			if body == "" {
+				continue
+			}
+
+			lines := strings.Split(body, "\n")
+			summary := []string{}
+			for i, line := range lines {
+				lines[i] = strings.TrimRight(line, " \t")
+				if lines[i] != "" {
+					summary = append(summary, strings.TrimSpace(lines[i]))
+				}
+			}
+			lines[0] = "- " + lines[0]
+			subsection.History = append(subsection.History, &model.Entry{Summary: "- " + strings.Join(summary, " "), Lines: lines})
+		}
+		sort.SliceStable(version.Subsections, func(i, j int) bool { return version.Subsections[i].Name < version.Subsections[j].Name })
+
+		result.Versions = append(result.Versions, version)
+	}
+
+	if len(errs) > 0 {
+		return result, errs
+	}
+
+	return result, nil
+}
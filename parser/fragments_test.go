@@ -0,0 +1,78 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+func TestFragmentsParser(t *testing.T) {
+	cl, err := parser.Fragments{}.ParsePath("testdata/fragments", parserConf())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(cl.Versions) != 1 || cl.Versions[0].Version != "Unreleased" {
+		t.Fatalf("expected a single Unreleased version, got %+v", cl.Versions)
+	}
+
+	got := []string{}
+	for _, s := range cl.Versions[0].Subsections {
+		for _, e := range s.History {
+			got = append(got, s.Name+": "+e.Summary)
+		}
+	}
+	want := []string{
+		"Added: - Baseline files",
+		"Added: - Support of change fragments",
+		"Fixed: - Crash on empty changelogs when linting in release mode",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected entries:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestFragmentsParserErrors(t *testing.T) {
+	testCases := []struct {
+		stream      string
+		err         string
+		subsections []string
+	}{
+		{
+			stream:      "kind: Added\nbody: one\n---\nbody: no kind\n---\nkind: Removed\n",
+			err:         "fragment 2 in stream has no kind",
+			subsections: []string{"Added", "Removed"},
+		},
+		{
+			stream:      "kind: Added\nbody: one\n---\nkind: [\n",
+			err:         "bad fragment in stream: yaml: line 4",
+			subsections: []string{"Added"},
+		},
+		{
+			stream: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		cl, err := parser.Fragments{}.Parse(strings.NewReader(tc.stream), parserConf())
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("unexpected error: %v", err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("expected error %q, got %v", tc.err, err)
+		}
+
+		got := []string{}
+		for _, v := range cl.Versions {
+			for _, s := range v.Subsections {
+				got = append(got, s.Name)
+			}
+		}
+		if strings.Join(got, ",") != strings.Join(tc.subsections, ",") {
+			t.Errorf("expected subsections %v, got %v", tc.subsections, got)
+		}
+	}
+}
+
+var _ parser.PathParser = parser.Fragments{}
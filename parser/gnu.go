@@ -0,0 +1,282 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// GNUChangeLog parses GNU-style ChangeLog files:
+//
+//	2022-11-19  John Doe  <john@example.com>
+//
+//		* src/main.c (main): Fix the exit code.
+//		(usage): Document -v.
+//
+// Each date and author block is a version identified by its date and author. The date is the date of the version,
+// in the YYYY-MM-DD layout (old-style dates like "Sat Nov 19 10:00:00 2022" are converted), the author and the email
+// are metadata of the version. Entries of a version are gathered in an unnamed subsection.
+type GNUChangeLog struct{}
+
+// GNUNews parses GNU-style NEWS files.
+// Level one headings, like "* Noteworthy changes in release 2.12 (2022-03-20) [stable]", are versions,
+// the version ?.? being Unreleased. The date between parentheses is the date of the version and the label between brackets is metadata.
+// Level two headings, like "** Bug fixes", are subsections, paragraphs and list items are entries.
+type GNUNews struct{}
+
+var (
+	gnuChangeLogHeadingPattern    = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})\s+(.+?)\s+<([^>]*)>\s*$`)
+	gnuChangeLogOldHeadingPattern = regexp.MustCompile(`^([A-Z][a-z]{2} [A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2}(?: [A-Z]{3,4})? \d{4})\s+(.+?)\s+<([^>]*)>\s*$`)
+	gnuChangeLogAuthorPattern     = regexp.MustCompile(`^\s+(.+?)\s+<([^>]*)>\s*$`)
+	// gnuChangeLogEndPattern matches lines after which the content of the changelog is ignored, like the copyright notice
+	gnuChangeLogEndPattern = regexp.MustCompile(`^(\f|;; Local Variables:|Local Variables:)`)
+	gnuNewsVersionPattern  = regexp.MustCompile(`^\*\s+(?:.*?\s)?(\d[\w.+~-]*|\?\.\?)(?:\s+\(([^)]*)\))?(?:\s+\[([^\]]*)\])?\s*$`)
+	gnuNewsItemPattern     = regexp.MustCompile(`^\s*[-*+] `)
+)
+
+// gnuDateLayout is the layout of dates of ChangeLog and NEWS files
+const gnuDateLayout = "2006-01-02"
+
+// gnuChangeLogOldDateLayouts are the layouts of old-style dates of ChangeLog files
+var gnuChangeLogOldDateLayouts = []string{"Mon Jan _2 15:04:05 2006", "Mon Jan _2 15:04:05 MST 2006"}
+
+// Name yields the name of the format of the changelogs handled by the parser
+func (GNUChangeLog) Name() string {
+	return "gnu-changelog"
+}
+
+// Parse parses a GNU ChangeLog.
+// Syntax errors do not stop the parsing: the parser skips lines until the next date and author line.
+func (p GNUChangeLog) Parse(r io.Reader, _ *Config) (*model.Changelog, error) {
+	result := model.NewChangelog()
+	result.Format = p.Name()
+	result.DateLayout = gnuDateLayout
+	result.VersionOrdering = model.OrderByDates
+	errs := SyntaxErrors{}
+	var currentVersion *model.Version
+	var currentEntry *model.Entry
+	skipping := false // true after a syntax error, until the next date and author line
+	previousLine := 0 // line number of the last non-empty line
+
+	scanner := newLineScanner(r)
+	pos := 0
+	for scanner.Scan() {
+		pos++
+		tok := token{fullText: scanner.Text(), pos: pos, offset: scanner.offset}
+		line := tok.fullText
+		if gnuChangeLogEndPattern.MatchString(line) {
+			break
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		gap := pos > previousLine+1
+		previousLine = pos
+
+		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			currentVersion, currentEntry = p.version(tok), nil
+			skipping = currentVersion == nil
+			if currentVersion == nil {
+				errs = append(errs, SyntaxError{
+					Message:  fmt.Sprintf("unexpected line: %s\nexpecting a date and author line like '2022-11-19  John Doe  <john@example.com>'", line),
+					Position: pos,
+					Range:    tok.lineRange(),
+				})
+				continue
+			}
+			result.Versions = append(result.Versions, currentVersion)
+			continue
+		}
+
+		if skipping {
+			continue
+		}
+
+		if currentVersion == nil {
+			errs = append(errs, SyntaxError{
+				Message:  fmt.Sprintf("unexpected line: %s\nexpecting a date and author line", line),
+				Position: pos,
+				Range:    tok.lineRange(),
+			})
+			skipping = true
+			continue
+		}
+
+		text := strings.TrimSpace(line)
+		switch {
+		case len(currentVersion.Subsections) == 0 && gnuChangeLogAuthorPattern.MatchString(line):
+			continue // co-author line
+		case strings.HasPrefix(text, "* ") || currentEntry == nil:
+			currentEntry = &model.Entry{Summary: text, Lines: []string{line}, Position: pos, Range: tok.lineRange()}
+			if len(currentVersion.Subsections) == 0 {
+				currentVersion.Subsections = append(currentVersion.Subsections, unnamedSubsection(tok))
+			}
+			subsection := currentVersion.Subsections[0]
+			subsection.History = append(subsection.History, currentEntry)
+		default:
+			currentEntry.Summary += " " + text
+			if gap {
+				currentEntry.Lines = append(currentEntry.Lines, "") // paragraph separator
+			}
+			currentEntry.Lines = append(currentEntry.Lines, line)
+			currentEntry.Range.End = tok.lineRange().End
+		}
+	}
+
+	return partialResult(result, errs)
+}
+
+// version builds the version of a date and author line, it returns nil if the line is not a date and author line
+func (GNUChangeLog) version(tok token) *model.Version {
+	line := tok.fullText
+	matches := gnuChangeLogHeadingPattern.FindStringSubmatchIndex(line)
+	oldStyle := matches == nil
+	if oldStyle {
+		matches = gnuChangeLogOldHeadingPattern.FindStringSubmatchIndex(line)
+	}
+	if matches == nil {
+		return nil
+	}
+
+	date := lineField(tok, matches[2], matches[3])
+	if oldStyle {
+		for _, layout := range gnuChangeLogOldDateLayouts {
+			if t, err := time.Parse(layout, date.Value); err == nil {
+				date.Value = t.Format(gnuDateLayout)
+				break
+			}
+		}
+	}
+	author := lineField(tok, matches[4], matches[5])
+
+	return &model.Version{
+		Version:    date.Value + " " + author.Value,
+		SourceLine: line,
+		Position:   tok.pos,
+		Range:      tok.lineRange(),
+		NameRange:  subRange(tok.lineRange(), line, matches[2], matches[5]),
+		Date:       date.Value,
+		DateRange:  date.Range,
+		Metadata: map[string]model.Field{
+			"author": author,
+			"email":  lineField(tok, matches[6], matches[7]),
+		},
+	}
+}
+
+// Name yields the name of the format of the changelogs handled by the parser
+func (GNUNews) Name() string {
+	return "gnu-news"
+}
+
+// Parse parses a GNU NEWS file.
+// Syntax errors do not stop the parsing: the parser skips lines until the next version.
+func (p GNUNews) Parse(r io.Reader, _ *Config) (*model.Changelog, error) {
+	result := model.NewChangelog()
+	result.Format = p.Name()
+	result.DateLayout = gnuDateLayout
+	result.VersionOrdering = model.OrderByUpstreamVersions
+	errs := SyntaxErrors{}
+	var currentVersion *model.Version
+	var currentSubsection *model.Subsection
+	var currentEntry *model.Entry
+	skipping := false // true after a syntax error, until the next version
+	previousLine := 0 // line number of the last non-empty line
+
+	scanner := newLineScanner(r)
+	pos := 0
+	for scanner.Scan() {
+		pos++
+		tok := token{fullText: scanner.Text(), pos: pos, offset: scanner.offset}
+		line := tok.fullText
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		gap := pos > previousLine+1
+		previousLine = pos
+
+		level := len(line) - len(strings.TrimLeft(line, "*"))
+		isHeading := level > 0 && strings.HasPrefix(line[level:], " ")
+		switch {
+		case isHeading && level == 1:
+			currentVersion, currentSubsection, currentEntry = p.version(tok), nil, nil
+			skipping = currentVersion == nil
+			if currentVersion == nil {
+				errs = append(errs, SyntaxError{
+					Message:  fmt.Sprintf("unexpected line: %s\nexpecting a version like '* Noteworthy changes in release 1.2 (2022-11-19) [stable]'", line),
+					Position: pos,
+					Range:    tok.lineRange(),
+				})
+				continue
+			}
+			result.Versions = append(result.Versions, currentVersion)
+		case skipping:
+			continue
+		case currentVersion == nil:
+			if len(result.Header) > 0 && gap {
+				result.Header = append(result.Header, "") // paragraph separator
+			}
+			result.Header = append(result.Header, line)
+		case isHeading:
+			name := strings.TrimSuffix(strings.TrimSpace(line[level:]), ":")
+			start := strings.Index(line, name)
+			currentSubsection = &model.Subsection{
+				Name:       name,
+				SourceLine: line,
+				Position:   pos,
+				Range:      tok.lineRange(),
+				NameRange:  subRange(tok.lineRange(), line, start, start+len(name)),
+			}
+			currentVersion.Subsections = append(currentVersion.Subsections, currentSubsection)
+			currentEntry = nil
+		case currentEntry == nil || gap || gnuNewsItemPattern.MatchString(line):
+			if currentSubsection == nil {
+				currentSubsection = unnamedSubsection(tok)
+				currentVersion.Subsections = append(currentVersion.Subsections, currentSubsection)
+			}
+			currentEntry = &model.Entry{Summary: strings.TrimSpace(line), Lines: []string{line}, Position: pos, Range: tok.lineRange()}
+			currentSubsection.History = append(currentSubsection.History, currentEntry)
+		default:
+			currentEntry.Summary += " " + strings.TrimSpace(line)
+			currentEntry.Lines = append(currentEntry.Lines, line)
+			currentEntry.Range.End = tok.lineRange().End
+		}
+	}
+
+	return partialResult(result, errs)
+}
+
+// version builds the version of a level one heading, it returns nil if the heading does not contain a version
+func (GNUNews) version(tok token) *model.Version {
+	line := tok.fullText
+	matches := gnuNewsVersionPattern.FindStringSubmatchIndex(line)
+	if matches == nil {
+		return nil
+	}
+
+	result := &model.Version{
+		Version:    line[matches[2]:matches[3]],
+		SourceLine: line,
+		Position:   tok.pos,
+		Range:      tok.lineRange(),
+		NameRange:  subRange(tok.lineRange(), line, matches[2], matches[3]),
+		Metadata:   map[string]model.Field{},
+	}
+	if result.Version == "?.?" {
+		result.Version = "Unreleased"
+	}
+
+	if matches[4] >= 0 && line[matches[4]:matches[5]] != "????-??-??" {
+		date := lineField(tok, matches[4], matches[5])
+		result.Date, result.DateRange = date.Value, date.Range
+	}
+	if matches[6] >= 0 && line[matches[6]:matches[7]] != "?" {
+		result.Metadata["label"] = lineField(tok, matches[6], matches[7])
+	}
+
+	return result
+}
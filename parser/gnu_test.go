@@ -0,0 +1,117 @@
+package parser_test
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+func TestGNUParsers(t *testing.T) {
+	testCases := []struct {
+		parser parser.Parser
+		file   string
+		want   []string
+	}{
+		{
+			parser: parser.GNUChangeLog{},
+			file:   "ChangeLog",
+			want: []string{
+				"2022-11-19 John Doe (line 1, date 2022-11-19)",
+				"  [] * src/main.c (main): Fix the exit code. (usage): Document -v.",
+				"  [] * Makefile.am: Add the tests.",
+				"2022-11-18 Jane Doe (line 7, date 2022-11-18)",
+				"  [] Free paragraph describing the change.",
+				"2022-11-04 John Doe (line 13, date 2022-11-04)",
+				"  [] * README: New file.",
+			},
+		},
+		{
+			parser: parser.GNUNews{},
+			file:   "NEWS",
+			want: []string{
+				"Unreleased (line 5, date )",
+				"  [New features] The greeting can be customized.",
+				"  [New features] - Translations were updated.",
+				"  [New features] - The manual was proofread.",
+				"2.12 (line 15, date 2022-03-20)",
+				"  [] Release without subsections.",
+				"  [Bug fixes] The example now compiles.",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		cl := parseGNU(t, tc.parser, tc.file)
+		got := []string{}
+		for _, v := range cl.Versions {
+			got = append(got, v.Version+" (line "+strconv.Itoa(v.Position)+", date "+v.Date+")")
+			for _, s := range v.Subsections {
+				for _, e := range s.History {
+					got = append(got, "  ["+s.Name+"] "+e.Summary)
+				}
+			}
+		}
+
+		if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", tc.file, strings.Join(tc.want, "\n"), strings.Join(got, "\n"))
+		}
+	}
+
+	news := parseGNU(t, parser.GNUNews{}, "NEWS")
+	if header := strings.Join(news.Header, "|"); header != "GNU hello NEWS                                    -*- outline -*-||Copyright notice." {
+		t.Errorf("unexpected NEWS header %q", header)
+	}
+	if label := news.Versions[1].Metadata["label"].Value; label != "stable" {
+		t.Errorf("expected label stable, got %q", label)
+	}
+}
+
+func TestGNUParsersErrors(t *testing.T) {
+	testCases := []struct {
+		parser parser.Parser
+		source string
+		lines  []int
+	}{
+		{parser.GNUChangeLog{}, "\t* orphan entry\n2022-11-19  John Doe  <john@example.com>\n\t* entry\nnot a date line\n\t* skipped\n", []int{1, 4}},
+		{parser.GNUNews{}, "* Not a release\n  skipped\n* Release 1.0\n  entry\n", []int{1}},
+	}
+
+	for _, tc := range testCases {
+		cl, err := tc.parser.Parse(strings.NewReader(tc.source), parserConf())
+		errs, ok := err.(parser.SyntaxErrors)
+		if !ok {
+			t.Fatalf("%s: expected syntax errors, got %v", tc.parser.Name(), err)
+		}
+		got := []int{}
+		for _, e := range errs {
+			got = append(got, e.Position)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.lines) {
+			t.Errorf("%s: expected errors at lines %v, got %v", tc.parser.Name(), tc.lines, errs)
+		}
+		if len(cl.Versions) != 1 || len(cl.Versions[0].Subsections[0].History) != 1 {
+			t.Errorf("%s: expected one version with one entry, got %+v", tc.parser.Name(), cl.Versions)
+		}
+	}
+}
+
+func parseGNU(t *testing.T, p parser.Parser, file string) *model.Changelog {
+	t.Helper()
+	input, err := os.Open("testdata/gnu/" + file)
+	if err != nil {
+		t.Fatalf("error reading test data: %v", err)
+	}
+	defer input.Close()
+
+	cl, err := p.Parse(input, parserConf())
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", file, err)
+	}
+
+	return cl
+}
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// outlineLine is a line of a changelog written in a lightweight markup language (reStructuredText, AsciiDoc)
+// once the syntax of the language is removed: headings are identified by their level whatever their notation.
+type outlineLine struct {
+	tok      token
+	heading  *heading // nil if the line is not a heading
+	item     bool     // true if the line starts a list item
+	indented bool
+}
+
+// heading is a section heading
+type heading struct {
+	level      int         // 1 for the highest level
+	start, end int         // byte offsets of the heading text in the line
+	extent     model.Range // source range of the heading, including the adornments of the text
+	lastLine   int         // line number of the last line of the heading
+}
+
+// outlineVersionPattern matches version headings like "1.2.0 (2022-11-19)", "Version 1.2.0" or "Unreleased"
+var outlineVersionPattern = regexp.MustCompile(`^(?:[Vv]ersion\s+|[Rr]elease\s+)?\[?v?(Unreleased|\d[\w.+~-]*)\]?(?:[\s:,(-].*)?$`)
+
+// outlineDatePattern matches the date following the version in version headings, like " - 2022-11-19" or " (2022-11-19)"
+var outlineDatePattern = regexp.MustCompile(`^\]?(?:\s+-\s+|\s*\()([^\s()]+)`)
+
+// outlineDateLayout is the layout of release dates of outline changelogs
+const outlineDateLayout = "2006-01-02"
+
+// outline builds the changelog of the given lines.
+// Versions are the headings of the level of the first heading containing a version, subsections are the headings
+// of the level below, and list items are entries. Lines preceding the first version are the header of the changelog.
+// Syntax errors do not stop the building: the lines are skipped until the next version or subsection.
+func outline(format string, lines []outlineLine) (*model.Changelog, error) {
+	result := model.NewChangelog()
+	result.Format = format
+	result.DateLayout = outlineDateLayout
+	errs := SyntaxErrors{}
+
+	versionLevel := 0
+	for _, l := range lines {
+		if l.heading != nil && outlineVersionPattern.MatchString(l.heading.text(l.tok)) {
+			versionLevel = l.heading.level
+			break
+		}
+	}
+
+	var currentVersion *model.Version
+	var currentSubsection *model.Subsection
+	var currentEntry *model.Entry
+	skipping := false // true after a syntax error, until the next version or subsection
+	previousLine := 0 // line number of the last line of content
+	for _, l := range lines {
+		gap := l.tok.pos > previousLine+1
+		previousLine = l.tok.pos
+		h := l.heading
+		if h != nil {
+			previousLine = h.lastLine
+		}
+
+		// unexpected records a syntax error on the line and skips lines until the next version or subsection
+		unexpected := func(expecting string) {
+			errs = append(errs, SyntaxError{
+				Message:  fmt.Sprintf("unexpected line: %s\nexpecting %s", l.tok.fullText, expecting),
+				Position: l.tok.pos,
+				Range:    l.tok.lineRange(),
+			})
+			currentEntry, skipping = nil, true
+		}
+
+		switch {
+		case h != nil && versionLevel > 0 && h.level == versionLevel:
+			currentVersion, currentSubsection, currentEntry = nil, nil, nil
+			matches := outlineVersionPattern.FindStringSubmatchIndex(h.text(l.tok))
+			if matches == nil {
+				unexpected("a version heading like '1.2.0 (2022-11-19)'")
+				continue
+			}
+			skipping = false
+			text := h.text(l.tok)
+			currentVersion = &model.Version{
+				Version:    text[matches[2]:matches[3]],
+				SourceLine: l.tok.fullText,
+				Position:   l.tok.pos,
+				Range:      h.extent,
+				NameRange:  subRange(l.tok.lineRange(), l.tok.fullText, h.start+matches[2], h.start+matches[3]),
+			}
+			if date := outlineDatePattern.FindStringSubmatchIndex(text[matches[3]:]); date != nil {
+				start, end := h.start+matches[3]+date[2], h.start+matches[3]+date[3]
+				currentVersion.Date = l.tok.fullText[start:end]
+				currentVersion.DateRange = subRange(l.tok.lineRange(), l.tok.fullText, start, end)
+			}
+			result.Versions = append(result.Versions, currentVersion)
+		case currentVersion == nil && !skipping:
+			line := l.tok.fullText
+			if h != nil {
+				line = h.text(l.tok)
+			}
+			if len(result.Header) > 0 && gap {
+				result.Header = append(result.Header, "") // paragraph separator
+			}
+			result.Header = append(result.Header, line)
+		case h != nil && h.level < versionLevel:
+			unexpected("a version heading")
+		case h != nil && h.level == versionLevel+1 && currentVersion != nil:
+			skipping = false
+			currentSubsection = &model.Subsection{
+				Name:       h.text(l.tok),
+				SourceLine: l.tok.fullText,
+				Position:   l.tok.pos,
+				Range:      h.extent,
+				NameRange:  subRange(l.tok.lineRange(), l.tok.fullText, h.start, h.end),
+			}
+			currentVersion.Subsections = append(currentVersion.Subsections, currentSubsection)
+			currentEntry = nil
+		case skipping:
+			continue
+		case h != nil:
+			unexpected("a version or subsection heading")
+		case l.item:
+			if currentSubsection == nil { // entries outside any subsection
+				currentSubsection = unnamedSubsection(l.tok)
+				currentVersion.Subsections = append(currentVersion.Subsections, currentSubsection)
+			}
+			currentEntry = &model.Entry{Summary: strings.TrimSpace(l.tok.fullText), Lines: []string{l.tok.fullText}, Position: l.tok.pos, Range: l.tok.lineRange()}
+			currentSubsection.History = append(currentSubsection.History, currentEntry)
+		case currentEntry != nil && (!gap || l.indented):
+			currentEntry.Summary += " " + strings.TrimSpace(l.tok.fullText)
+			if gap {
+				currentEntry.Lines = append(currentEntry.Lines, "") // paragraph separator
+			}
+			currentEntry.Lines = append(currentEntry.Lines, l.tok.fullText)
+			currentEntry.Range.End = l.tok.lineRange().End
+		default:
+			unexpected("a list item")
+		}
+	}
+
+	return partialResult(result, errs)
+}
+
+// text yields the text of the heading in the given line
+func (h heading) text(tok token) string {
+	return tok.fullText[h.start:h.end]
+}
+
+// scanLines yields the lines of a source
+func scanLines(scanner *lineScanner) []token {
+	result := []token{}
+	for scanner.Scan() {
+		result = append(result, token{fullText: scanner.Text(), pos: len(result) + 1, offset: scanner.offset})
+	}
+
+	return result
+}
+
+// isIndented returns true if the line starts with a space or a tab
+func isIndented(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
@@ -0,0 +1,147 @@
+package parser_test
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+func TestOutlineParsers(t *testing.T) {
+	testCases := []struct {
+		parser parser.Parser
+		file   string
+		header string
+		want   []string
+	}{
+		{
+			parser: parser.RST{},
+			file:   "CHANGES.rst",
+			header: "Changelog||All notable changes to this project are documented in this file.",
+			want: []string{
+				"Unreleased (line 10)",
+				"  Added (line 13)",
+				"    * New ``--verbose`` flag to print more details. Second paragraph of the entry. (lines 16-19)",
+				"    * Support of Python 3.11 (lines 20-20)",
+				"1.1.0 2022-11-19 (line 22)",
+				"  Fixed (line 25)",
+				"    - Crash on empty files (lines 28-28)",
+				"  Changed (line 30)",
+				"    - Faster parsing (lines 33-33)",
+				"1.0.0 2022-11-04 (line 35)",
+				"   (line 38)",
+				"    * First release (lines 38-38)",
+			},
+		},
+		{
+			parser: parser.AsciiDoc{},
+			file:   "CHANGELOG.adoc",
+			header: "Changelog|:toc:||All notable changes to this project are documented in this file.",
+			want: []string{
+				"Unreleased (line 9)",
+				"  Added (line 11)",
+				"    * New `--verbose` flag to print more details. ** nested item (lines 13-15)",
+				"    * Support of Python 3.11 (lines 16-16)",
+				"1.1.0 2022-11-19 (line 23)",
+				"  Fixed (line 25)",
+				"    - Crash on empty files (lines 27-27)",
+				"  Changed (line 29)",
+				"    - Faster parsing (lines 31-31)",
+				"1.0.0 2022-11-04 (line 33)",
+				"   (line 35)",
+				"    * First release (lines 35-35)",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		input, err := os.Open("testdata/outline/" + tc.file)
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+		cl, err := tc.parser.Parse(input, parserConf())
+		input.Close()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.file, err)
+		}
+
+		if cl.Format != tc.parser.Name() {
+			t.Errorf("%s: expected format %s, got %s", tc.file, tc.parser.Name(), cl.Format)
+		}
+		if header := strings.Join(cl.Header, "|"); header != tc.header {
+			t.Errorf("%s: unexpected header %q", tc.file, header)
+		}
+
+		got := []string{}
+		for _, v := range cl.Versions {
+			got = append(got, strings.TrimSpace(v.Version+" "+v.Date)+" (line "+strconv.Itoa(v.Position)+")")
+			for _, s := range v.Subsections {
+				got = append(got, "  "+s.Name+" (line "+strconv.Itoa(s.Position)+")")
+				for _, e := range s.History {
+					got = append(got, fmt.Sprintf("    %s (lines %d-%d)", e.Summary, e.Range.Start.Line, e.Range.End.Line))
+				}
+			}
+		}
+
+		if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", tc.file, strings.Join(tc.want, "\n"), strings.Join(got, "\n"))
+		}
+	}
+}
+
+func TestOutlineParsersRanges(t *testing.T) {
+	source := "=======\n1.0.0\n=======\n\nAdded\n-----\n\n* entry\n"
+	cl, err := parser.RST{}.Parse(strings.NewReader(source), parserConf())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v := cl.Versions[0]
+	if v.Position != 2 || v.NameRange.Start.Line != 2 || v.NameRange.Start.Column != 1 || v.NameRange.End.Column != 6 {
+		t.Errorf("expected the version name on line 2, columns 1-6, got line %d, range %+v", v.Position, v.NameRange)
+	}
+	if v.Range.Start.Line != 1 || v.Range.End.Line != 3 {
+		t.Errorf("expected the version heading on lines 1-3, got %+v", v.Range)
+	}
+	if s := v.Subsections[0]; s.Position != 5 || s.Range.End.Line != 6 {
+		t.Errorf("expected the subsection heading on lines 5-6, got line %d, range %+v", s.Position, s.Range)
+	}
+}
+
+func TestOutlineParsersErrors(t *testing.T) {
+	testCases := []struct {
+		parser parser.Parser
+		source string
+		lines  []int
+	}{
+		{parser.RST{}, "1.0.0\n=====\n\nAdded\n-----\n\nfree text\n\n* skipped\n\nFixed\n-----\n\n* entry\n", []int{7}},
+		{parser.RST{}, "1.0.0\n=====\n\n* entry\n\nNot a version\n=============\n\n* skipped\n", []int{6}},
+		{parser.AsciiDoc{}, "== 1.0.0\n\n=== Added\n\n==== Too deep\n\n* skipped\n\n=== Fixed\n\n* entry\n", []int{5}},
+	}
+
+	for _, tc := range testCases {
+		cl, err := tc.parser.Parse(strings.NewReader(tc.source), parserConf())
+		errs, ok := err.(parser.SyntaxErrors)
+		if !ok {
+			t.Fatalf("%s: expected syntax errors, got %v", tc.parser.Name(), err)
+		}
+		got := []int{}
+		for _, e := range errs {
+			got = append(got, e.Position)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.lines) {
+			t.Errorf("%s: expected errors at lines %v, got %v", tc.parser.Name(), tc.lines, errs)
+		}
+
+		entries := 0
+		for _, s := range cl.Versions[0].Subsections {
+			entries += len(s.History)
+		}
+		if len(cl.Versions) != 1 || entries != 1 {
+			t.Errorf("%s: expected one version with one entry, got %+v", tc.parser.Name(), cl.Versions)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// Parser parses changelogs of a given format
+type Parser interface {
+	// Parse parses a changelog, on syntax errors it returns SyntaxErrors and
+	// the parts of the changelog that could be parsed
+	Parse(r io.Reader, config *Config) (*model.Changelog, error)
+	// Name yields the name of the format of the changelogs handled by the parser
+	Name() string
+}
+
+// PathParser is implemented by parsers reading changelogs from a path rather than from a single stream,
+// like directories of change fragments
+type PathParser interface {
+	ParsePath(path string, config *Config) (*model.Changelog, error)
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]Parser{
+		Default{}.Name():      Default{},
+		Fragments{}.Name():    Fragments{},
+		Debian{}.Name():       Debian{},
+		GNUChangeLog{}.Name(): GNUChangeLog{},
+		GNUNews{}.Name():      GNUNews{},
+		RST{}.Name():          RST{},
+		AsciiDoc{}.Name():     AsciiDoc{},
+	}
+)
+
+// Register makes a parser available under its name, it replaces any parser previously registered with the same name
+func Register(p Parser) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	registry[p.Name()] = p
+}
+
+// Get yields the parser registered with the given name
+func Get(name string) (Parser, error) {
+	registryLock.RLock()
+	p, ok := registry[name]
+	registryLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown changelog format %q, available formats are: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return p, nil
+}
+
+// Names yields the sorted names of the registered parsers
+func Names() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	result := make([]string, 0, len(registry))
+	for name := range registry {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// unnamedSubsection yields a subsection without heading starting at the given token,
+// for entries of formats without subsections or outside any subsection
+func unnamedSubsection(tok token) *model.Subsection {
+	start := tok.lineRange().Start
+	return &model.Subsection{
+		Position:  tok.pos,
+		Range:     model.Range{Start: start, End: start},
+		NameRange: model.Range{Start: start, End: start},
+	}
+}
+
+// lineField yields the field of the token's text between the given byte offsets
+func lineField(tok token, start, end int) model.Field {
+	return model.Field{Value: tok.fullText[start:end], Range: subRange(tok.lineRange(), tok.fullText, start, end)}
+}
+
+// partialResult yields the result of a parser recovering from syntax errors: the parsed changelog,
+// even if partial, and the syntax errors sorted by position if any
+func partialResult(cl *model.Changelog, errs SyntaxErrors) (*model.Changelog, error) {
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Position < errs[j].Position })
+		return cl, errs
+	}
+
+	return cl, nil
+}
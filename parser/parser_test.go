@@ -0,0 +1,39 @@
+package parser_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+)
+
+type fakeParser struct{}
+
+func (fakeParser) Parse(io.Reader, *parser.Config) (*model.Changelog, error) {
+	return model.NewChangelog(), nil
+}
+
+func (fakeParser) Name() string {
+	return "fake"
+}
+
+func TestRegistry(t *testing.T) {
+	if p, err := parser.Get("markdown"); err != nil || p.Name() != "markdown" {
+		t.Fatalf("expected the markdown parser to be registered, got %v (error %v)", p, err)
+	}
+
+	if _, err := parser.Get("fake"); err == nil {
+		t.Fatalf("expected an error when getting an unregistered parser")
+	}
+
+	parser.Register(fakeParser{})
+	if p, err := parser.Get("fake"); err != nil || p.Name() != "fake" {
+		t.Fatalf("expected the fake parser to be registered, got %v (error %v)", p, err)
+	}
+
+	if names := strings.Join(parser.Names(), ","); !strings.Contains(names, "fake") || !strings.Contains(names, "markdown") {
+		t.Fatalf("expected registered parser names to contain fake and markdown, got %s", names)
+	}
+}
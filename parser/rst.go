@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// RST parses reStructuredText changelogs (CHANGES.rst):
+//
+//	Changelog
+//	=========
+//
+//	1.2.0 (2022-11-19)
+//	------------------
+//
+//	Added
+//	~~~~~
+//
+//	* Change description
+//	  continued
+//
+// Heading levels are given by the order in which their adornment styles appear, as in reStructuredText.
+// Versions are the headings of the level of the first heading containing a version,
+// subsections are the headings of the level below, and bullet list items are entries.
+// Positions of headings refer to their text line, not to their adornments.
+type RST struct{}
+
+// rstPunctuation are the characters of heading adornments
+const rstPunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// Name yields the name of the format of the changelogs handled by the parser
+func (RST) Name() string {
+	return "rst"
+}
+
+// Parse parses a reStructuredText changelog.
+// Syntax errors do not stop the parsing: the parser skips lines until the next version or subsection.
+func (p RST) Parse(r io.Reader, _ *Config) (*model.Changelog, error) {
+	return outline(p.Name(), p.lines(scanLines(newLineScanner(r))))
+}
+
+// lines yields the outline of the given source lines, comments and empty lines are dropped
+func (p RST) lines(source []token) []outlineLine {
+	result := []outlineLine{}
+	styles := map[string]int{} // levels of adornment styles
+	inComment := false
+	for i := 0; i < len(source); i++ {
+		tok := source[i]
+		line := tok.fullText
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indented := isIndented(line)
+		if inComment && indented {
+			continue
+		}
+		inComment = line == ".." || strings.HasPrefix(line, ".. ")
+		if inComment {
+			continue
+		}
+
+		if h, text, next := p.heading(source, i, styles); h != nil {
+			result = append(result, outlineLine{tok: text, heading: h})
+			i = next - 1
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		item := !indented && (strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "+ "))
+		result = append(result, outlineLine{tok: tok, item: item, indented: indented})
+	}
+
+	return result
+}
+
+// heading yields the heading starting at the i-th line, its text line and the index of the line following it.
+// It yields a nil heading if there is no heading at the i-th line.
+// The levels of adornment styles are recorded in styles as they appear.
+func (RST) heading(source []token, i int, styles map[string]int) (*heading, token, int) {
+	var style string
+	var text token
+	var next int
+	switch {
+	case i+2 < len(source) && rstIsAdornment(source[i].fullText) && strings.TrimSpace(source[i+1].fullText) != "" &&
+		strings.TrimRight(source[i+2].fullText, " \t") == strings.TrimRight(source[i].fullText, " \t"):
+		style, text, next = "overline "+source[i].fullText[:1], source[i+1], i+3
+	case i+1 < len(source) && !isIndented(source[i].fullText) && !rstIsAdornment(source[i].fullText) &&
+		rstIsAdornment(source[i+1].fullText):
+		style, text, next = source[i+1].fullText[:1], source[i], i+2
+	default:
+		return nil, token{}, i + 1
+	}
+
+	level, ok := styles[style]
+	if !ok {
+		level = len(styles) + 1
+		styles[style] = level
+	}
+
+	line := text.fullText
+	start := len(line) - len(strings.TrimLeft(line, " \t"))
+	last := source[next-1]
+
+	return &heading{
+		level:    level,
+		start:    start,
+		end:      len(strings.TrimRight(line, " \t")),
+		extent:   model.Range{Start: source[i].lineRange().Start, End: last.lineRange().End},
+		lastLine: last.pos,
+	}, text, next
+}
+
+// rstIsAdornment returns true if the line is a heading adornment: a repeated punctuation character
+func rstIsAdornment(line string) bool {
+	line = strings.TrimRight(line, " \t")
+	if utf8.RuneCountInString(line) < 2 || !strings.ContainsRune(rstPunctuation, rune(line[0])) {
+		return false
+	}
+
+	return strings.Count(line, line[:1]) == len(line)
+}
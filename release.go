@@ -0,0 +1,268 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chavacava/changelog-lint/config"
+	"github.com/chavacava/changelog-lint/formatter"
+	"github.com/chavacava/changelog-lint/linting"
+	"github.com/chavacava/changelog-lint/linting/rule"
+	"github.com/chavacava/changelog-lint/model"
+	"github.com/chavacava/changelog-lint/parser"
+	"github.com/chavacava/changelog-lint/versioning"
+)
+
+// runRelease turns the Unreleased version of the changelog into the given version and adds a fresh Unreleased version.
+// The changelog is written only if the result passes the release rule and the configured ones.
+func runRelease(args []string) int {
+	flags := flag.NewFlagSet(args[0]+" release", flag.ExitOnError)
+	flagConfig := flags.String("config", "", "set linter configuration")
+	flagDate := flags.String("date", "", "set the release date in the date layout of the parser configuration (default today)")
+	flagFailOn := flags.String("fail-on", string(linting.SeverityError), "set the minimum severity of failures preventing the release (error, warning, info)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s release [flags] <version> [changelog]\n", args[0])
+		flags.PrintDefaults()
+	}
+
+	freeArgs, err := parseInterspersed(flags, args[2:])
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if len(freeArgs) < 1 {
+		flags.Usage()
+		return codeRequestError
+	}
+	failOn, err := linting.ParseSeverity(*flagFailOn)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	mainConfig, err := config.LoadConfig(*flagConfig)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	scheme, err := mainConfig.VersioningScheme()
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	releaseVersion := freeArgs[0]
+	if scheme.Name() == versioning.SchemeSemVer {
+		releaseVersion = strings.TrimPrefix(releaseVersion, "v")
+	}
+	if err := scheme.Check(releaseVersion); err != nil {
+		fmt.Printf("bad release version: %v\n", err)
+		return codeRequestError
+	}
+
+	p, err := mainConfig.ChangelogParser()
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	if p.Name() != (parser.Default{}).Name() {
+		fmt.Printf("unable to release %s changelogs, only %s ones are supported\n", p.Name(), parser.Default{}.Name())
+		return codeRequestError
+	}
+
+	dateLayout := mainConfig.Parser.DateLayout
+	if *flagDate == "" {
+		*flagDate = time.Now().Format(dateLayout)
+	}
+	if _, err := time.Parse(dateLayout, *flagDate); err != nil {
+		fmt.Printf("bad release date %q, expecting a date like %s\n", *flagDate, dateLayout)
+		return codeRequestError
+	}
+
+	inputFilename := changelogFilename(freeArgs[1:])
+	source, err := os.ReadFile(inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	parse, err := newParseFunc(p, mainConfig, inputFilename)
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	changes, err := parse(source)
+	if err != nil {
+		fmt.Println(err)
+		return codeSyntaxError
+	}
+
+	edits, err := releaseEdits(changes, scheme, releaseVersion, *flagDate, linting.LineEnding(source))
+	if err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+	released, _ := linting.ApplyEdits(source, edits)
+
+	releasedChanges, err := parse(released)
+	if err != nil {
+		fmt.Printf("the released changelog is malformed: %v\n", err)
+		return codeSyntaxError
+	}
+
+	failures := releaseFailures(releasedChanges, mainConfig.LintingConfig(), releaseVersion)
+	blocking := []linting.Failure{}
+	for _, failure := range failures {
+		if failure.Severity.AtLeast(failOn) {
+			blocking = append(blocking, failure)
+		}
+	}
+	if len(blocking) > 0 {
+		fmt.Printf("%s not released:\n", inputFilename)
+		sortFailures(blocking)
+		if err := (formatter.Text{}).Format(os.Stdout, formatter.Report{Filename: inputFilename, Failures: blocking}); err != nil {
+			fmt.Println(err)
+			return codeRequestError
+		}
+		return codeLintError
+	}
+
+	if err := writeFile(inputFilename, released); err != nil {
+		fmt.Println(err)
+		return codeRequestError
+	}
+
+	return codeOK
+}
+
+// releaseEdits yields the edits of the source releasing the Unreleased version as the given version of the scheme:
+// the Unreleased heading is renamed, a fresh Unreleased heading is inserted above it
+// and the compare links of Unreleased and of the released version are updated, with the given line ending
+func releaseEdits(changes *model.Changelog, scheme versioning.Scheme, version, date, newline string) ([]linting.Edit, error) {
+	var unreleased, previous *model.Version
+	for i, v := range changes.Versions {
+		if versioning.Same(scheme, v.Version, version) {
+			return nil, fmt.Errorf("version %s is already in the changelog", version)
+		}
+		if v.Version == "Unreleased" && unreleased == nil {
+			unreleased = v
+			if i+1 < len(changes.Versions) {
+				previous = changes.Versions[i+1]
+			}
+		}
+	}
+	if unreleased == nil {
+		return nil, fmt.Errorf("the changelog has no Unreleased version to release")
+	}
+
+	// the released heading follows the style of the previous version, if any
+	style := previous
+	if style == nil {
+		style = unreleased
+	}
+	linkEdits := releaseLinkEdits(changes.Links, version, previous, newline)
+	heading := unreleased.Range
+	edits := []linting.Edit{{
+		Start:   heading.Start.Offset,
+		End:     heading.End.Offset,
+		NewText: unreleased.SourceLine + newline + newline + releaseHeading(style, version, date, len(linkEdits) > 0),
+	}}
+
+	return append(edits, linkEdits...), nil
+}
+
+// releaseHeading yields the heading of the released version in the style of the heading of the given version:
+// the same text before the name, between the name and the date and after the date.
+// The name is bracketed only if it is linked, and the date is omitted if the given version is a release without date.
+func releaseHeading(style *model.Version, version, date string, linked bool) string {
+	prefix, separator, suffix := "## ", " - ", ""
+	dated := true
+	line, start := style.SourceLine, style.Range.Start.Offset
+	nameStart, nameEnd := style.NameRange.Start.Offset-start, style.NameRange.End.Offset-start
+	if style.Position > 0 && 0 <= nameStart && nameStart <= nameEnd && nameEnd <= len(line) {
+		prefix = strings.TrimSuffix(line[:nameStart], "[")
+		tail := line[nameEnd:]
+		if len(prefix) < nameStart {
+			tail = strings.TrimPrefix(tail, "]")
+		}
+
+		dateStart, dateEnd := style.DateRange.Start.Offset-start, style.DateRange.End.Offset-start
+		switch {
+		case style.Date != "" && nameEnd <= dateStart && dateStart <= dateEnd && dateEnd <= len(line):
+			separator = tail[:len(tail)-len(line[dateStart:])]
+			suffix = line[dateEnd:]
+		case style.Version != "Unreleased":
+			dated = false
+		}
+	}
+
+	name := version
+	if linked {
+		name = "[" + version + "]"
+	}
+	if !dated {
+		return prefix + name
+	}
+
+	return prefix + name + separator + date + suffix
+}
+
+// releaseLinkEdits yields the edits of the link reference definitions when releasing the given version:
+// the compare link of Unreleased starts from the released version, and the released version gets
+// a compare link from the previous version. Links that are not compare URLs are left untouched.
+func releaseLinkEdits(links []*model.Link, version string, previous *model.Version, newline string) []linting.Edit {
+	for _, link := range links {
+		base, from, to, ok := link.Compare()
+		if !ok || !strings.EqualFold(link.Label, "Unreleased") {
+			continue
+		}
+
+		// tags are named after versions with a prefix, like v1.0.0, found in the tag of the previous version
+		tagPrefix := strings.TrimRightFunc(from, func(r rune) bool { return r != 'v' && r != '/' && r != '-' && r != '_' })
+		if previous != nil && strings.HasSuffix(from, previous.Version) {
+			tagPrefix = strings.TrimSuffix(from, previous.Version)
+		}
+		tag := tagPrefix + version
+
+		return []linting.Edit{{
+			Start: link.Range.Start.Offset,
+			End:   link.Range.End.Offset,
+			NewText: fmt.Sprintf("[%s]: %s%s...%s%s[%s]: %s%s...%s",
+				link.Label, base, tag, to, newline,
+				version, base, from, tag),
+		}}
+	}
+
+	return nil
+}
+
+// releaseFailures yields the failures of the released changelog: the failures of the configured rules
+// and of the release rule, applied without the fresh Unreleased version
+func releaseFailures(changes *model.Changelog, lintingConfig *linting.Config, version string) []linting.Failure {
+	released := *changes
+	if len(released.Versions) > 0 && released.Versions[0].Version == "Unreleased" && len(released.Versions[0].Subsections) == 0 {
+		released.Versions = released.Versions[1:]
+	}
+
+	result := lint(*changes, lintingConfig)
+	releaseConfig := &linting.Config{RuleArgs: map[linting.Rule]linting.RuleArgs{rule.Release{}: version}}
+
+	return append(result, lint(released, releaseConfig)...)
+}
+
+// lint yields the failures of the changelog
+func lint(changes model.Changelog, lintingConfig *linting.Config) []linting.Failure {
+	failures := make(chan linting.Failure)
+	go linting.Linter{}.Lint(changes, lintingConfig, failures)
+
+	result := []linting.Failure{}
+	for failure := range failures {
+		result = append(result, failure)
+	}
+
+	return result
+}
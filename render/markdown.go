@@ -0,0 +1,177 @@
+// Package render renders changelogs
+package render
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// Markdown renders changelogs as Markdown in a normalized style:
+//   - one blank line between blocks (paragraphs, headings and lists),
+//   - one space between the heading marker and the heading text,
+//   - the same list marker for all entries,
+//   - continuation lines of entries indented under the entry text,
+//   - no trailing whitespace.
+type Markdown struct{}
+
+// listMarker is the marker of rendered entries
+const listMarker = "-"
+
+// Render writes the Markdown of the changelog
+func (r Markdown) Render(w io.Writer, changes model.Changelog) error {
+	blocks := []string{}
+
+	header := []string{}
+	for i, line := range changes.Header {
+		if i == 0 {
+			blocks = append(blocks, r.heading(1, line))
+			continue
+		}
+
+		line = strings.TrimRight(line, " \t")
+		if line != "" {
+			header = append(header, line)
+			continue
+		}
+		if len(header) > 0 {
+			blocks = append(blocks, strings.Join(header, "\n"))
+			header = []string{}
+		}
+	}
+	if len(header) > 0 {
+		blocks = append(blocks, strings.Join(header, "\n"))
+	}
+
+	directives := append([]*model.Directive{}, changes.Directives...)
+	sort.SliceStable(directives, func(i, j int) bool { return directives[i].Position < directives[j].Position })
+	// withDirectives prefixes the rendering of the node at the given line with the directives preceding the node,
+	// directives are kept right before the node to preserve the meaning of disable-next-line directives
+	withDirectives := func(line int, rendering string) string {
+		lines := []string{}
+		for len(directives) > 0 && directives[0].Position < line {
+			lines = append(lines, r.directive(directives[0]))
+			directives = directives[1:]
+		}
+
+		return strings.Join(append(lines, rendering), "\n")
+	}
+
+	for _, version := range changes.Versions {
+		blocks = append(blocks, withDirectives(version.Position, r.heading(2, version.SourceLine)))
+		blocks = append(blocks, r.subsections(version, withDirectives)...)
+	}
+
+	if len(changes.Links) > 0 {
+		links := make([]string, len(changes.Links))
+		for i, link := range changes.Links {
+			links[i] = withDirectives(link.Position, r.link(link))
+		}
+		blocks = append(blocks, strings.Join(links, "\n"))
+	}
+
+	trailing := []string{}
+	for _, d := range directives {
+		trailing = append(trailing, r.directive(d))
+	}
+	if len(trailing) > 0 {
+		blocks = append(blocks, strings.Join(trailing, "\n"))
+	}
+
+	_, err := io.WriteString(w, strings.Join(blocks, "\n\n")+"\n")
+	return err
+}
+
+// RenderNotes writes the Markdown of the subsections and entries of the version, without the version heading:
+// the release notes of the version
+func (r Markdown) RenderNotes(w io.Writer, version model.Version) error {
+	blocks := r.subsections(&version, func(_ int, rendering string) string { return rendering })
+
+	_, err := io.WriteString(w, strings.Join(blocks, "\n\n")+"\n")
+	return err
+}
+
+// subsections yields the blocks of the subsections of the version,
+// withDirectives prefixes the rendering of a node at a given line with the directives preceding it
+func (r Markdown) subsections(version *model.Version, withDirectives func(line int, rendering string) string) []string {
+	blocks := []string{}
+	for _, subsection := range version.Subsections {
+		if strings.TrimSpace(subsection.SourceLine) != "" { // entries of formats without subsections have no heading
+			blocks = append(blocks, withDirectives(subsection.Position, r.heading(3, subsection.SourceLine)))
+		}
+		if len(subsection.History) == 0 {
+			continue
+		}
+
+		entries := make([]string, len(subsection.History))
+		for i, entry := range subsection.History {
+			entries[i] = withDirectives(entry.Position, r.entry(entry))
+		}
+		blocks = append(blocks, strings.Join(entries, "\n"))
+	}
+
+	return blocks
+}
+
+// heading normalizes the source line of a heading of the given level
+func (Markdown) heading(level int, sourceLine string) string {
+	text := strings.TrimSpace(sourceLine)
+	text = strings.TrimSpace(strings.TrimLeft(text, "#"))
+
+	return strings.Repeat("#", level) + " " + text
+}
+
+// directive renders a linter directive
+func (Markdown) directive(d *model.Directive) string {
+	return "<!-- " + strings.Join(append([]string{"changelog-lint-" + d.Kind}, d.Rules...), " ") + " -->"
+}
+
+// link renders a link reference definition
+func (Markdown) link(l *model.Link) string {
+	result := "[" + l.Label + "]: " + l.URL
+	if l.Title != "" {
+		result += " " + l.Title
+	}
+
+	return result
+}
+
+// entry renders an entry, continuation lines are indented under the entry text
+func (Markdown) entry(e *model.Entry) string {
+	lines := e.Lines
+	if len(lines) == 0 {
+		lines = []string{e.Summary}
+	}
+
+	first := strings.TrimRight(lines[0], " \t")
+	text := strings.TrimLeft(first, " ")
+	indent := first[:len(first)-len(text)]
+	text = strings.TrimSpace(strings.TrimLeft(text, "-*"))
+
+	result := []string{indent + listMarker + " " + text}
+	continuation := lines[1:]
+	minIndent := -1
+	for _, line := range continuation {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lineIndent := len(line) - len(strings.TrimLeft(line, " "))
+		if minIndent < 0 || lineIndent < minIndent {
+			minIndent = lineIndent
+		}
+	}
+
+	continuationIndent := indent + strings.Repeat(" ", len(listMarker)+1)
+	for _, line := range continuation {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			result = append(result, "")
+			continue
+		}
+		result = append(result, continuationIndent+line[minIndent:])
+	}
+
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,72 @@
+package render_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/parser"
+	"github.com/chavacava/changelog-lint/render"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestMarkdown(t *testing.T) {
+	testCases := []string{"unformatted.md", "keepachangelog.md"}
+
+	for _, tc := range testCases {
+		source, err := os.ReadFile(filepath.Join("testdata", tc))
+		if err != nil {
+			t.Fatalf("error reading test data: %v", err)
+		}
+
+		got := renderMarkdown(t, tc, source)
+
+		goldenPath := filepath.Join("testdata", "formatted", tc)
+		if *update {
+			if err := os.WriteFile(goldenPath, got, 0o644); err != nil {
+				t.Fatalf("%s: unable to update golden file: %v", tc, err)
+			}
+		}
+
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("%s: error reading golden file: %v", tc, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("%s: output does not match %s, got:\n%s", tc, goldenPath, got)
+		}
+
+		// rendering is idempotent
+		if again := renderMarkdown(t, tc, got); !bytes.Equal(again, got) {
+			t.Fatalf("%s: rendering the rendered changelog changes it, got:\n%s", tc, again)
+		}
+	}
+}
+
+func renderMarkdown(t *testing.T, name string, source []byte) []byte {
+	t.Helper()
+	changes, err := parser.Default{}.Parse(bytes.NewReader(source), parserConf())
+	if err != nil {
+		t.Fatalf("%s: unexpected parsing error: %v", name, err)
+	}
+
+	var result bytes.Buffer
+	if err := (render.Markdown{}).Render(&result, *changes); err != nil {
+		t.Fatalf("%s: unexpected rendering error: %v", name, err)
+	}
+
+	return result.Bytes()
+}
+
+func parserConf() *parser.Config {
+	return &parser.Config{
+		TitlePattern:      regexp.MustCompile(`.+`),
+		VersionPattern:    regexp.MustCompile(`^## \[?(v?\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?|Unreleased)\]?(?: +- +(?P<date>\S+))?( .*)*$`),
+		SubsectionPattern: regexp.MustCompile(`^### ([A-Z]+[a-z]+)[ ]*$`),
+		EntryPattern:      regexp.MustCompile(`^[*-] .+$`),
+	}
+}
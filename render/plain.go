@@ -0,0 +1,34 @@
+package render
+
+import (
+	"io"
+	"strings"
+
+	"github.com/chavacava/changelog-lint/model"
+)
+
+// Plain renders changelogs as plain text, without Markdown headings:
+// subsection names are followed by a colon and entries are written on a single line.
+type Plain struct{}
+
+// RenderNotes writes the plain text of the subsections and entries of the version, without the version heading:
+// the release notes of the version
+func (Plain) RenderNotes(w io.Writer, version model.Version) error {
+	blocks := []string{}
+	for _, subsection := range version.Subsections {
+		lines := []string{}
+		if subsection.Name != "" {
+			lines = append(lines, subsection.Name+":")
+		}
+		for _, entry := range subsection.History {
+			text := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(entry.Summary), "-*+"))
+			lines = append(lines, listMarker+" "+strings.Join(strings.Fields(text), " "))
+		}
+		if len(lines) > 0 {
+			blocks = append(blocks, strings.Join(lines, "\n"))
+		}
+	}
+
+	_, err := io.WriteString(w, strings.Join(blocks, "\n\n")+"\n")
+	return err
+}
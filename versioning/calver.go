@@ -0,0 +1,127 @@
+package versioning
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CalVer is the scheme of calendar versions (https://calver.org) following a format made of tokens and separators (. - _).
+// Tokens are YYYY (2026), YY (26, years since 2000 without padding), 0Y (26, padded to two digits),
+// MM (1 to 12), 0M (01 to 12), WW (week, 1 to 53), 0W (01 to 53), DD (1 to 31), 0D (01 to 31),
+// MAJOR, MINOR and MICRO (numbers), and MODIFIER (like dev or rc1) that must be the last token, after a separator.
+// The modifier is optional, like in 2026.10.1 and 2026.10.1-rc1 for the format YYYY.MM.MICRO-MODIFIER.
+// Versions are ordered by their numbers, a version with a modifier precedes the version without it.
+type CalVer struct {
+	format      string
+	pattern     *regexp.Regexp
+	hasModifier bool
+}
+
+// calVerTokens are the patterns of the tokens of CalVer formats
+var calVerTokens = map[string]string{
+	"YYYY":  `\d{4}`,
+	"YY":    `0|[1-9]\d*`,
+	"0Y":    `\d{2,}`,
+	"MM":    `[1-9]|1[0-2]`,
+	"0M":    `0[1-9]|1[0-2]`,
+	"WW":    `[1-9]|[1-4]\d|5[0-3]`,
+	"0W":    `0[1-9]|[1-4]\d|5[0-3]`,
+	"DD":    `[1-9]|[12]\d|3[01]`,
+	"0D":    `0[1-9]|[12]\d|3[01]`,
+	"MAJOR": `0|[1-9]\d*`,
+	"MINOR": `0|[1-9]\d*`,
+	"MICRO": `0|[1-9]\d*`,
+}
+
+const calVerModifier = "MODIFIER"
+
+// calVerFormatPattern splits CalVer formats into tokens and separators
+var calVerFormatPattern = regexp.MustCompile(`[A-Z0-9]+|[._-]|.`)
+
+// NewCalVer yields the CalVer scheme of the given format, like YYYY.MM.MICRO
+func NewCalVer(format string) (CalVer, error) {
+	if format == "" {
+		return CalVer{}, fmt.Errorf("empty calver format, expecting a format like YYYY.MM.MICRO")
+	}
+
+	pattern := "^"
+	hasModifier := false
+	parts := calVerFormatPattern.FindAllString(format, -1)
+	for i, part := range parts {
+		tokenPattern, isToken := calVerTokens[part]
+		switch {
+		case isToken:
+			pattern += "(" + tokenPattern + ")"
+		case part == calVerModifier && i == len(parts)-1 && i > 1 && isCalVerSeparator(parts[i-1]):
+			// the modifier is optional: releases have no modifier
+			separator := regexp.QuoteMeta(parts[i-1])
+			pattern = strings.TrimSuffix(pattern, separator) + "(?:" + separator + `([0-9A-Za-z]+(?:[.-][0-9A-Za-z]+)*))?`
+			hasModifier = true
+		case isCalVerSeparator(part):
+			pattern += regexp.QuoteMeta(part)
+		default:
+			return CalVer{}, fmt.Errorf("bad calver format %q: unknown token %q", format, part)
+		}
+	}
+
+	return CalVer{format: format, pattern: regexp.MustCompile(pattern + "$"), hasModifier: hasModifier}, nil
+}
+
+func isCalVerSeparator(part string) bool {
+	return part == "." || part == "-" || part == "_"
+}
+
+func (c CalVer) Name() string {
+	return SchemeCalVer + ":" + c.format
+}
+
+func (c CalVer) Check(version string) error {
+	if c.pattern.MatchString(version) {
+		return nil
+	}
+
+	return fmt.Errorf("%q does not follow the calendar versioning format %s", version, c.format)
+}
+
+func (c CalVer) Compare(v1, v2 string) int {
+	numbers1, modifier1 := c.parts(v1)
+	numbers2, modifier2 := c.parts(v2)
+	for i := 0; i < len(numbers1) && i < len(numbers2); i++ {
+		if result := compareInts(numbers1[i], numbers2[i]); result != 0 {
+			return result
+		}
+	}
+
+	switch {
+	case modifier1 == modifier2:
+		return 0
+	case modifier1 == "":
+		return 1
+	case modifier2 == "":
+		return -1
+	default:
+		return strings.Compare(modifier1, modifier2)
+	}
+}
+
+// parts yields the numbers and the modifier of the version
+func (c CalVer) parts(version string) (numbers []int64, modifier string) {
+	matches := c.pattern.FindStringSubmatch(version)
+	if matches == nil {
+		return nil, ""
+	}
+
+	matches = matches[1:]
+	if c.hasModifier {
+		modifier = matches[len(matches)-1]
+		matches = matches[:len(matches)-1]
+	}
+	for _, match := range matches {
+		number, _ := strconv.ParseInt(match, 10, 64)
+		numbers = append(numbers, number)
+	}
+
+	return numbers, modifier
+}
@@ -0,0 +1,25 @@
+package versioning
+
+import (
+	"errors"
+	"strings"
+)
+
+// Lexical is the scheme of free-form versions, ordered as strings
+type Lexical struct{}
+
+func (Lexical) Name() string {
+	return SchemeLexical
+}
+
+func (Lexical) Check(version string) error {
+	if strings.TrimSpace(version) == "" {
+		return errors.New("empty version")
+	}
+
+	return nil
+}
+
+func (Lexical) Compare(v1, v2 string) int {
+	return strings.Compare(v1, v2)
+}
@@ -0,0 +1,177 @@
+package versioning
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PEP440 is the scheme of versions of Python packages (https://peps.python.org/pep-0440/),
+// like 1.0, 1!2.0, 1.0a1, 1.0rc2, 1.0.post1, 1.0.dev3 or 1.0+local.7.
+// Non-normalized forms, like 1.0-alpha.1 or v1.0, are accepted.
+type PEP440 struct{}
+
+// pep440Pattern is the pattern of versions from the appendix B of the PEP 440
+var pep440Pattern = regexp.MustCompile(`(?i)^v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?:[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?:-(?P<post_n1>[0-9]+)|[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?)?` +
+	`(?:[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+
+// phases of pre-releases, in precedence order
+const (
+	pep440DevPhase     = iota // development releases without pre-release nor post-release part precede pre-releases
+	pep440AlphaPhase          // a
+	pep440BetaPhase           // b
+	pep440RCPhase             // rc
+	pep440ReleasePhase        // no pre-release part
+)
+
+// pep440Version is the sort key of a PEP 440 version
+type pep440Version struct {
+	epoch   int64
+	release []int64 // without trailing zeros
+	phase   int
+	pre     int64
+	hasPost bool
+	post    int64
+	hasDev  bool
+	dev     int64
+	local   []string
+}
+
+func (PEP440) Name() string {
+	return SchemePEP440
+}
+
+func (PEP440) Check(version string) error {
+	if pep440Pattern.MatchString(version) {
+		return nil
+	}
+
+	return fmt.Errorf("%q is not a PEP 440 version", version)
+}
+
+func (PEP440) Compare(v1, v2 string) int {
+	key1, key2 := parsePEP440(v1), parsePEP440(v2)
+	if result := compareInts(key1.epoch, key2.epoch); result != 0 {
+		return result
+	}
+	if result := compareIntSlices(key1.release, key2.release); result != 0 {
+		return result
+	}
+	if result := compareInts(int64(key1.phase), int64(key2.phase)); result != 0 {
+		return result
+	}
+	if result := compareInts(key1.pre, key2.pre); result != 0 {
+		return result
+	}
+	if result := compareOptionalInts(key1.hasPost, key1.post, key2.hasPost, key2.post, -1); result != 0 {
+		return result // versions without post-release part precede post-releases
+	}
+	if result := compareOptionalInts(key1.hasDev, key1.dev, key2.hasDev, key2.dev, 1); result != 0 {
+		return result // development releases precede versions without development release part
+	}
+
+	return compareLocals(key1.local, key2.local)
+}
+
+// parsePEP440 yields the sort key of a version, the zero key if the version is not a PEP 440 version
+func parsePEP440(version string) pep440Version {
+	matches := pep440Pattern.FindStringSubmatch(version)
+	if matches == nil {
+		return pep440Version{}
+	}
+
+	group := func(name string) string { return strings.ToLower(matches[pep440Pattern.SubexpIndex(name)]) }
+	number := func(text string) int64 {
+		result, _ := strconv.ParseInt(text, 10, 64)
+		return result
+	}
+
+	result := pep440Version{epoch: number(group("epoch")), phase: pep440ReleasePhase}
+	for _, part := range strings.Split(group("release"), ".") {
+		result.release = append(result.release, number(part))
+	}
+	for len(result.release) > 1 && result.release[len(result.release)-1] == 0 {
+		result.release = result.release[:len(result.release)-1]
+	}
+
+	switch group("pre_l") {
+	case "a", "alpha":
+		result.phase = pep440AlphaPhase
+	case "b", "beta":
+		result.phase = pep440BetaPhase
+	case "c", "rc", "pre", "preview":
+		result.phase = pep440RCPhase
+	}
+	result.pre = number(group("pre_n"))
+
+	result.hasPost = group("post_n1") != "" || group("post_l") != ""
+	result.post = number(group("post_n1") + group("post_n2"))
+	result.hasDev = group("dev_l") != ""
+	result.dev = number(group("dev_n"))
+	if result.hasDev && !result.hasPost && result.phase == pep440ReleasePhase {
+		result.phase = pep440DevPhase
+	}
+
+	if local := group("local"); local != "" {
+		result.local = strings.FieldsFunc(local, func(r rune) bool { return r == '-' || r == '_' || r == '.' })
+	}
+
+	return result
+}
+
+// compareIntSlices compares slices of integers element by element, a slice precedes the longer slices it prefixes
+func compareIntSlices(s1, s2 []int64) int {
+	for i := 0; i < len(s1) && i < len(s2); i++ {
+		if result := compareInts(s1[i], s2[i]); result != 0 {
+			return result
+		}
+	}
+
+	return compareInts(int64(len(s1)), int64(len(s2)))
+}
+
+// compareOptionalInts compares optional integers, a missing integer is compared as 1 to present ones if missing is 1,
+// as -1 if missing is -1
+func compareOptionalInts(has1 bool, i1 int64, has2 bool, i2 int64, missing int) int {
+	switch {
+	case has1 && has2:
+		return compareInts(i1, i2)
+	case has1:
+		return -missing
+	case has2:
+		return missing
+	default:
+		return 0
+	}
+}
+
+// compareLocals compares local version labels: versions without local label come first,
+// numeric segments are compared as numbers and follow alphanumeric segments, compared as strings
+func compareLocals(l1, l2 []string) int {
+	for i := 0; i < len(l1) && i < len(l2); i++ {
+		n1, err1 := strconv.ParseInt(l1[i], 10, 64)
+		n2, err2 := strconv.ParseInt(l2[i], 10, 64)
+		var result int
+		switch {
+		case err1 == nil && err2 == nil:
+			result = compareInts(n1, n2)
+		case err1 == nil:
+			result = 1
+		case err2 == nil:
+			result = -1
+		default:
+			result = strings.Compare(l1[i], l2[i])
+		}
+		if result != 0 {
+			return result
+		}
+	}
+
+	return compareInts(int64(len(l1)), int64(len(l2)))
+}
@@ -0,0 +1,22 @@
+package versioning
+
+import "github.com/chavacava/changelog-lint/model"
+
+// SemVer is the scheme of semantic versions (https://semver.org/spec/v2.0.0.html), with an optional v prefix
+type SemVer struct{}
+
+func (SemVer) Name() string {
+	return SchemeSemVer
+}
+
+func (SemVer) Check(version string) error {
+	_, err := model.ParseSemVer(version)
+	return err
+}
+
+func (SemVer) Compare(v1, v2 string) int {
+	semVer1, _ := model.ParseSemVer(v1)
+	semVer2, _ := model.ParseSemVer(v2)
+
+	return semVer1.Compare(semVer2)
+}
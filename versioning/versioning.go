@@ -0,0 +1,71 @@
+// Package versioning implements the versioning schemes of changelogs: the syntax and the precedence of version names.
+//
+// Schemes are selected by name:
+//   - semver: semantic versions like 1.2.0-rc.1 (https://semver.org), the default scheme,
+//   - calver:<format>: calendar versions following the given format, like calver:YYYY.MM.MICRO for 2026.10.1
+//     or calver:YY.0M for 26.10 (see CalVer for the tokens of formats),
+//   - pep440: Python versions like 1.0.post1 or 2.0rc1 (https://peps.python.org/pep-0440/),
+//   - lexical: free-form versions ordered as strings.
+package versioning
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scheme is a versioning scheme
+type Scheme interface {
+	// Name yields the name of the scheme, as used to select it
+	Name() string
+	// Check returns an error if the version does not follow the scheme
+	Check(version string) error
+	// Compare yields -1, 0 or 1 if the precedence of v1 is lower, equal or higher than the one of v2.
+	// Versions must pass the check.
+	Compare(v1, v2 string) int
+}
+
+// Names of the schemes
+const (
+	SchemeSemVer  = "semver"
+	SchemeCalVer  = "calver"
+	SchemePEP440  = "pep440"
+	SchemeLexical = "lexical"
+)
+
+// New yields the scheme of the given name, the empty name selects the default scheme (semver)
+func New(name string) (Scheme, error) {
+	switch {
+	case name == "" || name == SchemeSemVer:
+		return SemVer{}, nil
+	case name == SchemePEP440:
+		return PEP440{}, nil
+	case name == SchemeLexical:
+		return Lexical{}, nil
+	case strings.HasPrefix(name, SchemeCalVer+":"):
+		return NewCalVer(strings.TrimPrefix(name, SchemeCalVer+":"))
+	default:
+		return nil, fmt.Errorf("unknown versioning scheme %q, available schemes are: %s, %s:<format>, %s, %s", name, SchemeSemVer, SchemeCalVer, SchemePEP440, SchemeLexical)
+	}
+}
+
+// Same returns true if the versions have the same precedence in the scheme,
+// versions that do not follow the scheme are the same only if their names are equal
+func Same(scheme Scheme, v1, v2 string) bool {
+	if scheme.Check(v1) != nil || scheme.Check(v2) != nil {
+		return v1 == v2
+	}
+
+	return scheme.Compare(v1, v2) == 0
+}
+
+// compareInts yields -1, 0 or 1 if a is lower, equal or greater than b
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
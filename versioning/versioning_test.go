@@ -0,0 +1,151 @@
+package versioning_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chavacava/changelog-lint/versioning"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name string
+		want string // name of the scheme, empty if the name is bad
+	}{
+		{"", "semver"},
+		{"semver", "semver"},
+		{"pep440", "pep440"},
+		{"lexical", "lexical"},
+		{"calver:YYYY.MM.MICRO", "calver:YYYY.MM.MICRO"},
+		{"calver:YY.0M.0D-MODIFIER", "calver:YY.0M.0D-MODIFIER"},
+		{"calver:", ""},
+		{"calver:YYYY.MONTH", ""},
+		{"calver:MODIFIER", ""},
+		{"calver:YYYY.MODIFIER.MICRO", ""},
+		{"calver", ""},
+		{"debian", ""},
+	}
+
+	for _, tc := range testCases {
+		scheme, err := versioning.New(tc.name)
+		switch {
+		case tc.want == "" && err == nil:
+			t.Errorf("%q: expected an error, got scheme %s", tc.name, scheme.Name())
+		case tc.want != "" && err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.name, err)
+		case tc.want != "" && scheme.Name() != tc.want:
+			t.Errorf("%q: expected scheme %s, got %s", tc.name, tc.want, scheme.Name())
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		scheme string
+		valid  []string
+		bad    []string
+	}{
+		{"semver", []string{"1.2.3", "v1.2.3-rc.1+build.5"}, []string{"1.2", "2026.10"}},
+		{
+			"calver:YYYY.MM.MICRO",
+			[]string{"2026.10.0", "2026.1.12"},
+			[]string{"2026.10", "2026.01.0", "2026.13.0", "26.10.0", "2026.10.0-rc1", "v2026.10.0"},
+		},
+		{
+			"calver:YY.0M.0D-MODIFIER",
+			[]string{"26.10.18", "26.01.02-rc1", "6.01.02-beta.2"},
+			[]string{"26.10.32", "26.1.02", "26.01.02-", "26.01.02rc1"},
+		},
+		{"calver:YYYY_0W", []string{"2026_05", "2026_53"}, []string{"2026_54", "2026_5", "2026.05"}},
+		{
+			"pep440",
+			[]string{"1.0", "v1.0", "1!2.0", "1.0a1", "1.0-alpha.1", "1.0RC2", "1.0.post1", "1.0-1", "1.0.dev3", "1.0+ubuntu.7"},
+			[]string{"1.0.0-rc.1.2", "1.0+", "1.0_final", "one"},
+		},
+		{"lexical", []string{"1.0", "jammy", "release 7"}, []string{"", " "}},
+	}
+
+	for _, tc := range testCases {
+		scheme, err := versioning.New(tc.scheme)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.scheme, err)
+		}
+		for _, version := range tc.valid {
+			if err := scheme.Check(version); err != nil {
+				t.Errorf("%s: unexpected error checking %q: %v", tc.scheme, version, err)
+			}
+		}
+		for _, version := range tc.bad {
+			if err := scheme.Check(version); err == nil {
+				t.Errorf("%s: expected an error checking %q", tc.scheme, version)
+			}
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// versions of increasing precedence, versions separated by = have the same precedence
+	testCases := []struct {
+		scheme  string
+		ordered string
+	}{
+		{"semver", "1.0.0-alpha 1.0.0-alpha.1 1.0.0-beta 1.0.0-rc.1 1.0.0=v1.0.0=1.0.0+build.1 1.0.1 1.10.0 2.0.0"},
+		{"calver:YYYY.MM.MICRO", "2025.12.3 2026.1.0 2026.1.1 2026.10.0 2026.10.2 2026.10.10"},
+		{"calver:YY.0M-MODIFIER", "25.12 26.01-beta 26.01-rc1 26.01 26.10-dev 26.10"},
+		{
+			"pep440",
+			"1.0.dev1 1.0a1.dev1 1.0a1 1.0a2.post1 1.0b1 1.0rc1=1.0c1=1.0-pre1 1.0=1.0.0=v1.0 1.0+abc 1.0+abc.5 1.0+5 " +
+				"1.0.post1.dev1 1.0.post1=1.0-1=1.0.rev1 1.0.1 1.10 1!0.1",
+		},
+		{"lexical", "1.10 1.9 bionic focal jammy"},
+	}
+
+	for _, tc := range testCases {
+		scheme, err := versioning.New(tc.scheme)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.scheme, err)
+		}
+
+		type rankedVersion struct {
+			name string
+			rank int
+		}
+		var versions []rankedVersion
+		for rank, group := range strings.Fields(tc.ordered) {
+			for _, name := range strings.Split(group, "=") {
+				versions = append(versions, rankedVersion{name, rank})
+			}
+		}
+
+		for _, v1 := range versions {
+			if err := scheme.Check(v1.name); err != nil {
+				t.Fatalf("%s: unexpected error checking %q: %v", tc.scheme, v1.name, err)
+			}
+			for _, v2 := range versions {
+				want := 0
+				switch {
+				case v1.rank < v2.rank:
+					want = -1
+				case v1.rank > v2.rank:
+					want = 1
+				}
+				if got := scheme.Compare(v1.name, v2.name); got != want {
+					t.Errorf("%s: comparing %s and %s: expected %d, got %d", tc.scheme, v1.name, v2.name, want, got)
+				}
+				if got := versioning.Same(scheme, v1.name, v2.name); got != (want == 0) {
+					t.Errorf("%s: expected %s and %s to be the same: %v, got %v", tc.scheme, v1.name, v2.name, want == 0, got)
+				}
+			}
+		}
+	}
+}
+
+func TestSameMalformed(t *testing.T) {
+	scheme := versioning.SemVer{}
+	if !versioning.Same(scheme, "1.2", "1.2") {
+		t.Error("expected equal malformed versions to be the same")
+	}
+	if versioning.Same(scheme, "1.2", "1.2.0") {
+		t.Error("expected malformed and well-formed versions to differ")
+	}
+}